@@ -0,0 +1,102 @@
+package checkers
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock provides the current time to the time checkers.
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time { return time.Now() }
+
+// SystemClock is the Clock reading the system time.
+var SystemClock Clock = systemClock{}
+
+// DefaultClock is the Clock used by the time checkers to resolve the current
+// time: IsRecent, IsInFuture and the times created with FromNow.
+// Tests can replace it with a FakeClock using UseClock.
+var DefaultClock = SystemClock
+
+// UseClock sets DefaultClock and returns a function restoring the previous
+// one. For example:
+//
+//	clock := NewFakeClock(start)
+//	defer UseClock(clock)()
+func UseClock(clock Clock) (restore func()) {
+	prev := DefaultClock
+	DefaultClock = clock
+	return func() { DefaultClock = prev }
+}
+
+// FakeClock is a Clock which moves only when told to. It is safe for
+// concurrent use.
+type FakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewFakeClock returns a FakeClock set to the given time.
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+// Now returns the current time of the clock.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Advance moves the clock by d, which may be negative.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// Set sets the current time of the clock.
+func (c *FakeClock) Set(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = now
+}
+
+// RelativeTime is a time relative to the current time of DefaultClock. It is
+// resolved when the check runs, so it can be passed to the time checkers in
+// place of a time.Time.
+type RelativeTime time.Duration
+
+// FromNow returns the time d after the current time of DefaultClock, resolved
+// when the check runs. Use a negative d for a time in the past.
+// For example:
+//
+//	c.Assert(user.CreatedAt, WithinDuration, FromNow(0), time.Second)
+//	c.Assert(token.ExpiresAt, TimeBetween(FromNow(time.Hour), FromNow(2*time.Hour)))
+func FromNow(d time.Duration) RelativeTime {
+	return RelativeTime(d)
+}
+
+// Time returns the time the RelativeTime refers to at the moment.
+func (r RelativeTime) Time() time.Time {
+	return DefaultClock.Now().Add(time.Duration(r))
+}
+
+func (r RelativeTime) String() string {
+	return "now" + signed(time.Duration(r))
+}
+
+// signed formats a duration with an explicit sign, omitting zero.
+func signed(d time.Duration) string {
+	switch {
+	case d > 0:
+		return "+" + d.String()
+	case d < 0:
+		return d.String()
+	}
+	return ""
+}
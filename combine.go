@@ -0,0 +1,168 @@
+package checkers
+
+import (
+	"fmt"
+	"strings"
+
+	gc "gopkg.in/check.v1"
+)
+
+// bound is a checker with all its arguments, except the obtained value, bound.
+type bound struct {
+	sub  gc.Checker
+	args []interface{}
+	name string
+}
+
+// Bind binds the arguments following the obtained value to a checker, so it
+// can be passed to the combinators (AllOf, AnyOf, NoneOf) and the element
+// quantifiers. It panics if the number of the arguments doesn't match the
+// checker, so the mistake can't be hidden by Not.
+// For example:
+//
+//	c.Assert(s, AllOf(Bind(HasPrefix, "foo"), Bind(HasSuffix, "bar")))
+func Bind(checker gc.Checker, args ...interface{}) gc.Checker {
+	info := checker.Info()
+	if len(info.Params) != len(args)+1 {
+		panic(fmt.Sprintf("Wrong number of parameters for %s: want %d, got %d",
+			info.Name, len(info.Params)-1, len(args)))
+	}
+	return &bound{checker, args, callName(info.Name, args)}
+}
+
+// bindChecker binds the arguments to a checker, if it needs any. It panics
+// if the number of the arguments doesn't match the checker.
+func bindChecker(checker gc.Checker, args []interface{}) gc.Checker {
+	if len(args) == 0 && len(checker.Info().Params) == 1 {
+		return checker
+	}
+	return Bind(checker, args...)
+}
+
+// mustTakeObtained panics if any of the checkers requires more arguments than
+// the obtained value.
+func mustTakeObtained(checkers ...gc.Checker) {
+	for _, checker := range checkers {
+		if info := checker.Info(); len(info.Params) != 1 {
+			panic(fmt.Sprintf("%s expects %d arguments, use Bind(%s, ...)",
+				info.Name, len(info.Params)-1, info.Name))
+		}
+	}
+}
+
+// callName formats a checker name with its bound arguments, eg `Between(1, 5)`.
+func callName(name string, args []interface{}) string {
+	strArgs := make([]string, len(args))
+	for i, a := range args {
+		strArgs[i] = fmt.Sprintf("%#v", a)
+	}
+	return fmt.Sprintf("%s(%s)", name, strings.Join(strArgs, ", "))
+}
+
+func (c *bound) Info() *gc.CheckerInfo {
+	return &gc.CheckerInfo{Name: c.name, Params: []string{"obtained"}}
+}
+
+func (c *bound) Check(params []interface{}, names []string) (result bool, error string) {
+	return c.sub.Check(append([]interface{}{params[0]}, c.args...), append([]string{}, c.sub.Info().Params...))
+}
+
+// checkOne runs a checker which takes only the obtained value. It reports
+// whether the check passed and the error of the checker, if any.
+func checkOne(checker gc.Checker, obtained interface{}) (bool, string) {
+	info := checker.Info()
+	if len(info.Params) != 1 {
+		return false, fmt.Sprintf("expects %d arguments, use Bind(%s, ...)", len(info.Params)-1, info.Name)
+	}
+	result, errStr := checker.Check([]interface{}{obtained}, []string{info.Params[0]})
+	return result && errStr == "", errStr
+}
+
+// runBranch runs a checker which takes only the obtained value. It returns
+// the explanation of the result.
+func runBranch(checker gc.Checker, obtained interface{}) (bool, string) {
+	name := checker.Info().Name
+	ok, errStr := checkOne(checker, obtained)
+	switch {
+	case ok:
+		return true, name + ": passed"
+	case errStr == "":
+		return false, name + ": failed"
+	}
+	return false, name + ": " + indent(errStr)
+}
+
+// indent indents the continuation lines of a nested checker error.
+func indent(errStr string) string {
+	return strings.Replace(errStr, "\n", "\n  ", -1)
+}
+
+// -----------------------------------------------------------------------
+type combinator struct {
+	kind     string
+	checkers []gc.Checker
+}
+
+func (c *combinator) Info() *gc.CheckerInfo {
+	names := make([]string, len(c.checkers))
+	for i, checker := range c.checkers {
+		names[i] = checker.Info().Name
+	}
+	return &gc.CheckerInfo{
+		Name:   fmt.Sprintf("%s(%s)", c.kind, strings.Join(names, ", ")),
+		Params: []string{"obtained"},
+	}
+}
+
+func (c *combinator) Check(params []interface{}, names []string) (result bool, error string) {
+	var passed, failed []string
+	for _, checker := range c.checkers {
+		if ok, explanation := runBranch(checker, params[0]); ok {
+			passed = append(passed, explanation)
+		} else {
+			failed = append(failed, explanation)
+		}
+	}
+	reasons, verb := failed, "failed"
+	switch c.kind {
+	case "AnyOf":
+		if len(passed) > 0 {
+			return true, ""
+		}
+		if len(failed) == 0 {
+			return false, "AnyOf: no checkers given"
+		}
+	case "NoneOf":
+		reasons, verb = passed, "passed"
+	}
+	if len(reasons) == 0 {
+		return true, ""
+	}
+	return false, fmt.Sprintf("%s: %d of %d checks %s:\n...     %s",
+		c.kind, len(reasons), len(c.checkers), verb, strings.Join(reasons, "\n...     "))
+}
+
+// AllOf checks if the obtained value passes all the given checkers. Checkers
+// requiring more arguments than the obtained value must be wrapped with Bind,
+// otherwise AllOf panics.
+// For example:
+//
+//	c.Assert(s, AllOf(Bind(HasPrefix, "foo"), Bind(HasSuffix, "bar")))
+func AllOf(checkers ...gc.Checker) gc.Checker {
+	mustTakeObtained(checkers...)
+	return &combinator{"AllOf", checkers}
+}
+
+// AnyOf checks if the obtained value passes at least one of the given
+// checkers. See AllOf.
+func AnyOf(checkers ...gc.Checker) gc.Checker {
+	mustTakeObtained(checkers...)
+	return &combinator{"AnyOf", checkers}
+}
+
+// NoneOf checks if the obtained value doesn't pass any of the given checkers.
+// See AllOf.
+func NoneOf(checkers ...gc.Checker) gc.Checker {
+	mustTakeObtained(checkers...)
+	return &combinator{"NoneOf", checkers}
+}
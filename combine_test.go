@@ -0,0 +1,72 @@
+package checkers
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+type CombineSuite struct{}
+
+func (s *CombineSuite) TestBind(c *C) {
+	c.Check("foo bar", Bind(HasPrefix, "foo"))
+	c.Check(3, Bind(Between, 1, 5))
+	c.Check(3, Not(Bind(Between, 4, 5)))
+	c.Check(Bind(Between, 1, 5).Info().Name, Equals, "Between(1, 5)")
+
+	c.Check(func() { Bind(Between, 1) }, PanicMatches, "Wrong number of parameters for Between: want 2, got 1")
+	// the mistake must not be hidden by Not, which clears the checker error
+	c.Check(func() { c.Check(3, Not(Bind(Between, 4))) }, PanicMatches, "Wrong number .*")
+}
+
+func (s *CombineSuite) TestAllOf(c *C) {
+	c.Check("foo bar", AllOf(Bind(HasPrefix, "foo"), Bind(HasSuffix, "bar")))
+	c.Check("foo bar", Not(AllOf(Bind(HasPrefix, "foo"), Bind(HasSuffix, "baz"))))
+	c.Check("x", AllOf())
+
+	res, msg := AllOf(Bind(HasPrefix, "foo"), Bind(HasSuffix, "baz"), Bind(HasPrefix, 1)).
+		Check([]interface{}{"foo bar"}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "AllOf: 2 of 3 checks failed:\n"+
+		`...     HasSuffix("baz"): failed`+"\n"+
+		`...     HasPrefix(1): expected must be a string`)
+}
+
+func (s *CombineSuite) TestAnyOf(c *C) {
+	c.Check(3, AnyOf(Bind(Between, 1, 5), IsEmpty))
+	c.Check(0, AnyOf(Bind(Between, 1, 5), IsEmpty))
+	c.Check(7, Not(AnyOf(Bind(Between, 1, 5), IsEmpty)))
+	c.Check(7, Not(AnyOf()))
+
+	res, msg := AnyOf(Bind(Between, 1, 5), IsEmpty).Check([]interface{}{7}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "AnyOf: 2 of 2 checks failed:\n"+
+		"...     Between(1, 5): failed\n"+
+		"...     IsEmpty: failed")
+
+	c.Check(func() { AnyOf(IsEmpty, Between) }, PanicMatches, `Between expects 2 arguments, use Bind\(Between, ...\)`)
+	c.Check(func() { Not(AllOf(Bind(Between, 4))) }, PanicMatches, "Wrong number .*")
+}
+
+func (s *CombineSuite) TestNoneOf(c *C) {
+	c.Check(7, NoneOf(Bind(Between, 1, 5), IsEmpty))
+	c.Check(3, Not(NoneOf(Bind(Between, 1, 5), IsEmpty)))
+
+	res, msg := NoneOf(Bind(Between, 1, 5), IsEmpty).Check([]interface{}{3}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "NoneOf: 1 of 2 checks passed:\n...     Between(1, 5): passed")
+
+	c.Check(func() { NoneOf(Bind(Between, 1)) }, PanicMatches, "Wrong number of parameters for Between: want 2, got 1")
+}
+
+func (s *CombineSuite) TestNested(c *C) {
+	isShortFoo := AllOf(Bind(HasPrefix, "foo"), Not(Bind(HasSuffix, "long")))
+	c.Check("foo", AnyOf(isShortFoo, IsEmpty))
+	c.Check("", AnyOf(isShortFoo, IsEmpty))
+	c.Check("foo long", Not(AnyOf(isShortFoo, IsEmpty)))
+
+	res, msg := AnyOf(isShortFoo, IsEmpty).Check([]interface{}{"foo long"}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "AnyOf: 2 of 2 checks failed:\n"+
+		`...     AllOf(HasPrefix("foo"), Not(HasSuffix("long"))): AllOf: 1 of 2 checks failed:`+"\n"+
+		`  ...     Not(HasSuffix("long")): failed`+"\n"+
+		"...     IsEmpty: failed")
+}
@@ -1,6 +1,7 @@
 package checkers
 
 import (
+	"bytes"
 	"fmt"
 	"reflect"
 
@@ -17,31 +18,146 @@ type containsChecker struct {
 func (c *containsChecker) Check(params []interface{}, names []string) (result bool, error string) {
 	container := params[0]
 	value := params[1]
-	vtype := reflect.TypeOf(value)
-	vv := reflect.ValueOf(value)
 	cv := reflect.ValueOf(container)
 
 	switch cv.Kind() {
 	case reflect.Slice, reflect.Array:
-		if cv.Type().Elem() != vtype {
-			return false, ""
+		if sub, ok := substring(value); ok && cv.Type().Elem().Kind() == reflect.Uint8 && cv.Kind() == reflect.Slice {
+			return bytes.Contains(cv.Bytes(), []byte(sub)), ""
+		}
+		if errStr := checkElemType(cv.Type().Elem(), value); errStr != "" {
+			return false, errStr
 		}
 		for i := 0; i < cv.Len(); i++ {
-			if reflect.DeepEqual(cv.Index(i).Interface(), value) {
+			if elemEquals(cv.Index(i), value) {
+				return true, ""
+			}
+		}
+		return false, ""
+	case reflect.Map:
+		key, ok, errStr := mapKey(cv, value)
+		if !ok {
+			return false, errStr
+		}
+		return cv.MapIndex(key).IsValid(), ""
+	case reflect.Chan:
+		if errStr := checkElemType(cv.Type().Elem(), value); errStr != "" {
+			return false, errStr
+		}
+		elems, errStr := elements(container)
+		if errStr != "" {
+			return false, errStr
+		}
+		for _, e := range elems {
+			if elemEquals(reflect.ValueOf(&e.value).Elem(), value) {
 				return true, ""
 			}
 		}
 		return false, ""
-	case reflect.String:
-		if vtype.Kind() != reflect.String {
-			return false, fmt.Sprint("value should have type: ", vtype)
+	}
+	if str, ok := stringValue(container); ok {
+		sub, ok := substring(value)
+		if !ok {
+			return false, fmt.Sprintf("value should be a string, []byte or rune, got %T", value)
+		}
+		return strings.Contains(str, sub), ""
+	}
+	return false, fmt.Sprintf("Unsupported argument types: %T, %T", container, value)
+}
+
+// substring converts a value looked for in a string to a string.
+func substring(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case []byte:
+		return string(v), true
+	case rune:
+		return string(v), true
+	}
+	return stringValue(value)
+}
+
+// stringValue converts a value of any string kind, including named string
+// types, or a fmt.Stringer to a string.
+func stringValue(value interface{}) (string, bool) {
+	if v := reflect.ValueOf(value); v.Kind() == reflect.String {
+		return v.String(), true
+	}
+	return stringOrStringer(value)
+}
+
+// checkElemType checks if the value can be compared with elements of type t.
+func checkElemType(t reflect.Type, value interface{}) string {
+	vv := reflect.ValueOf(value)
+	if !vv.IsValid() {
+		if canBeNil(t) {
+			return ""
+		}
+		return fmt.Sprintf("elements of type %s can't be nil", t)
+	}
+	if vv.Type() == t || t.Kind() == reflect.Interface {
+		return ""
+	}
+	if _, ok := elemConverter(t, vv.Type()); ok {
+		return ""
+	}
+	return fmt.Sprintf("value of type %s can't be compared with elements of type %s", vv.Type(), t)
+}
+
+// elemEquals checks if a container element equals the value. The value is
+// compared with the dynamic value of interface elements, and numbers and
+// strings are converted to the element type if it can be done without loss.
+func elemEquals(elem reflect.Value, value interface{}) bool {
+	if elem.Kind() == reflect.Interface && !elem.IsNil() {
+		elem = elem.Elem()
+	}
+	if reflect.DeepEqual(elem.Interface(), value) {
+		return true
+	}
+	vv := reflect.ValueOf(value)
+	if !vv.IsValid() || !elem.IsValid() || vv.Type() == elem.Type() {
+		return false
+	}
+	if c, ok := convertTo(vv, elem.Type()); ok {
+		return reflect.DeepEqual(elem.Interface(), c.Interface())
+	}
+	return false
+}
+
+// mapKey converts a value to the key type of the map m. It returns false if
+// the value is of a compatible type, but it can't be a key of m, eg it's out
+// of the range of the key type or it's a slice looked up in a map with
+// interface keys.
+func mapKey(m reflect.Value, key interface{}) (reflect.Value, bool, string) {
+	kt := m.Type().Key()
+	kv := reflect.ValueOf(key)
+	switch {
+	case !kv.IsValid():
+		if canBeNil(kt) {
+			return reflect.Zero(kt), true, ""
+		}
+		return kv, false, fmt.Sprintf("keys of type %s can't be nil", kt)
+	case kt.Kind() == reflect.Interface && kv.Type().Implements(kt):
+		if !canHash(kv) {
+			// an unhashable value can't be a key, and MapIndex would panic
+			return kv, false, ""
 		}
-		return strings.Contains(cv.String(), vv.String()), ""
+		return kv.Convert(kt), true, ""
+	}
+	if _, ok := elemConverter(kt, kv.Type()); !ok {
+		return kv, false, fmt.Sprintf("key of type %s can't be compared with keys of type %s", kv.Type(), kt)
 	}
-	return false, fmt.Sprint("Unsupported argument types: ", cv.Kind(), vtype)
+	kv, ok := convertTo(kv, kt)
+	return kv, ok, ""
 }
 
-// Contains checker checks if an array, slice or string contains an element
+// Contains checker checks if an array, slice, channel or string contains an
+// element, or if a map contains a key. Strings (and fmt.Stringer values) and
+// []byte are searched for a substring. The buffered values of a channel are
+// received and sent back in the same order, so the channel must not be used
+// concurrently; a closed channel is drained and reported as an error. The
+// value is compared with the dynamic value of interface elements, and numbers
+// and strings are converted to the element type if it can be done without
+// loss.
 var Contains gc.Checker = &containsChecker{
 	&gc.CheckerInfo{Name: "Contains", Params: []string{"Container", "Value expected to contain"}}}
 
@@ -53,10 +169,81 @@ func (c *isInChecker) Check(params []interface{}, names []string) (result bool,
 	return cBis.Check([]interface{}{params[1], params[0]}, names)
 }
 
-// IsIn checker checks if an element belongs to an array, slice or a string
+// IsIn checker checks if an element belongs to an array, slice, channel or a
+// string, or if it is a key of a map. See Contains.
 var IsIn gc.Checker = &isInChecker{
 	&gc.CheckerInfo{Name: "IsIn", Params: []string{"Element", "Container"}}}
 
+// -----------------------------------------------------------------------
+type mapChecker struct {
+	*gc.CheckerInfo
+	check func(m reflect.Value, params []interface{}) (bool, string)
+}
+
+func (c *mapChecker) Check(params []interface{}, names []string) (result bool, error string) {
+	m := reflect.ValueOf(params[0])
+	if m.Kind() != reflect.Map {
+		return false, fmt.Sprintf("obtained value must be a map, got %T", params[0])
+	}
+	return c.check(m, params[1:])
+}
+
+// HasKey checks if a map contains the key. Numbers and strings are converted
+// to the key type if it can be done without loss.
+var HasKey gc.Checker = &mapChecker{
+	&gc.CheckerInfo{Name: "HasKey", Params: []string{"obtained", "key"}},
+	func(m reflect.Value, params []interface{}) (bool, string) {
+		key, ok, errStr := mapKey(m, params[0])
+		if !ok {
+			return false, errStr
+		}
+		return m.MapIndex(key).IsValid(), ""
+	}}
+
+// HasValue checks if a map contains the value. Values are compared like in
+// Contains.
+var HasValue gc.Checker = &mapChecker{
+	&gc.CheckerInfo{Name: "HasValue", Params: []string{"obtained", "value"}},
+	func(m reflect.Value, params []interface{}) (bool, string) {
+		if errStr := checkElemType(m.Type().Elem(), params[0]); errStr != "" {
+			return false, errStr
+		}
+		for _, k := range m.MapKeys() {
+			if elemEquals(m.MapIndex(k), params[0]) {
+				return true, ""
+			}
+		}
+		return false, ""
+	}}
+
+// HasEntry checks if a map contains the key with the value. Keys and values
+// are compared like in HasKey and HasValue.
+// For example:
+//
+//	c.Assert(headers, HasEntry, "Content-Type", "application/json")
+var HasEntry gc.Checker = &mapChecker{
+	&gc.CheckerInfo{Name: "HasEntry", Params: []string{"obtained", "key", "value"}},
+	func(m reflect.Value, params []interface{}) (bool, string) {
+		key, ok, errStr := mapKey(m, params[0])
+		if !ok && errStr == "" {
+			return false, fmt.Sprintf("key %#v not found", params[0])
+		}
+		if !ok {
+			return false, errStr
+		}
+		if errStr := checkElemType(m.Type().Elem(), params[1]); errStr != "" {
+			return false, errStr
+		}
+		v := m.MapIndex(key)
+		if !v.IsValid() {
+			return false, fmt.Sprintf("key %#v not found", params[0])
+		}
+		if !elemEquals(v, params[1]) {
+			return false, fmt.Sprintf("key %#v has value %s", params[0], formatValue(v))
+		}
+		return true, ""
+	}}
+
 // -----------------------------------------------------------------------
 type sliceEquals struct {
 	*gc.CheckerInfo
@@ -71,11 +258,12 @@ func (c *sliceEquals) Check(params []interface{}, names []string) (result bool,
 	if vs1.Kind() != reflect.Slice || vs2.Kind() != reflect.Slice {
 		return false, "Both arguments must be slices"
 	}
-	l := vs1.Len()
-	if l != vs2.Len() {
-		return false, ""
+	if reflect.DeepEqual(s1, s2) {
+		return true, ""
 	}
-	return reflect.DeepEqual(s1, s2), ""
+	d := differ{}
+	d.diff("", vs1, vs2)
+	return false, d.String()
 }
 
 // SliceEquals check if two slices has the same values
@@ -96,11 +284,12 @@ func (c *mapEquals) Check(params []interface{}, names []string) (result bool, er
 	if vs1.Kind() != reflect.Map || vs2.Kind() != reflect.Map {
 		return false, "Both arguments must be maps"
 	}
-	l := vs1.Len()
-	if l != vs2.Len() {
-		return false, ""
+	if reflect.DeepEqual(s1, s2) {
+		return true, ""
 	}
-	return reflect.DeepEqual(s1, s2), ""
+	d := differ{}
+	d.diff("", vs1, vs2)
+	return false, d.String()
 }
 
 // MapEquals check if two maps has the same values
@@ -113,9 +302,13 @@ type sameContent struct {
 	*gc.CheckerInfo
 }
 
-// SameContent checks that the obtained slice contains all the values (and
-// same number of values) of the expected slice and vice versa, without respect
-// to order or duplicates. Uses DeepEquals on mapped contents to compare.
+// SameContent checks that the obtained slice or array contains all the values
+// (and same number of values) of the expected slice or array and vice versa,
+// without respect to order or duplicates. Uses DeepEquals to compare the
+// elements, so they don't need to be comparable, or their `Equal(T) bool`
+// method if they have one, like time.Time. Numbers and strings of
+// different types are converted to the element type of the obtained value if
+// it is done without loss.
 var SameContent gc.Checker = &sameContent{
 	&gc.CheckerInfo{Name: "SameContent", Params: []string{"obtained", "expected"}},
 }
@@ -124,69 +317,536 @@ func (checker *sameContent) Check(params []interface{}, names []string) (result
 	if len(params) != 2 {
 		return false, "SameContent expects two slice arguments"
 	}
-	obtained := params[0]
-	expected := params[1]
-
-	tob := reflect.TypeOf(obtained)
-	if tob.Kind() != reflect.Slice {
+	vob := reflect.ValueOf(params[0])
+	if !isSeq(vob) {
 		return false, fmt.Sprintf("SameContent expects the obtained value to be a slice, got %q",
-			tob.Kind())
+			vob.Kind())
 	}
-
-	texp := reflect.TypeOf(expected)
-	if texp.Kind() != reflect.Slice {
+	vexp := reflect.ValueOf(params[1])
+	if !isSeq(vexp) {
 		return false, fmt.Sprintf("SameContent expects the expected value to be a slice, got %q",
-			texp.Kind())
+			vexp.Kind())
 	}
-
-	if texp != tob {
+	convert, ok := elemConverter(vob.Type().Elem(), vexp.Type().Elem())
+	if !ok {
 		return false, fmt.Sprintf(
 			"SameContent expects two slices of the same type, expected: %q, got: %q",
-			texp, tob)
+			vexp.Type(), vob.Type())
+	}
+
+	// count the entries, keeping them in the order of their first appearance
+	m := multiset{}
+	for i := 0; i < vexp.Len(); i++ {
+		m.add(convert(vexp.Index(i)).Interface()).expected++
+	}
+	for i := 0; i < vob.Len(); i++ {
+		m.add(vob.Index(i).Interface()).obtained++
 	}
 
-	vexp := reflect.ValueOf(expected)
-	vob := reflect.ValueOf(obtained)
-	length := vexp.Len()
+	d := differ{}
+	d.diffCounts(m.counts)
+	return d.equal(), d.String()
+}
 
-	if vob.Len() != length {
-		// Slice has incorrect number of elements
-		return false, ""
+func isSeq(v reflect.Value) bool {
+	return v.Kind() == reflect.Slice || v.Kind() == reflect.Array
+}
+
+// elemConverter returns a function converting the elements of type from to
+// the type to. It returns false if the types are not compatible. Numbers and
+// strings are converted if it can be done without loss; if an element can't,
+// it is returned unchanged. Interface types are compatible with any type.
+func elemConverter(to, from reflect.Type) (func(reflect.Value) reflect.Value, bool) {
+	identity := func(v reflect.Value) reflect.Value { return v }
+	if to == from || to.Kind() == reflect.Interface || from.Kind() == reflect.Interface {
+		return identity, true
+	}
+	toClass, fromClass := kindClass(to.Kind()), kindClass(from.Kind())
+	if toClass == "" || fromClass == "" || (toClass == "string") != (fromClass == "string") {
+		return nil, false
 	}
+	return func(v reflect.Value) reflect.Value {
+		c, _ := convertTo(v, to)
+		return c
+	}, true
+}
 
-	// spin up maps with the entries as keys and the counts as values
-	mob := make(map[interface{}]int, length)
-	mexp := make(map[interface{}]int, length)
+// multiset counts the occurrences of values, comparing them with
+// reflect.DeepEqual, or with their `Equal(T) bool` method if they have one,
+// like time.Time. Hashable values are indexed in a map, the others are
+// compared one by one.
+type multiset struct {
+	counts []elemCount
+	index  map[interface{}]int
+}
 
-	for i := 0; i < length; i++ {
-		mexp[vexp.Index(i).Interface()]++
-		mob[vob.Index(i).Interface()]++
+// indexable checks if the value can be indexed in a map.
+func indexable(e interface{}) bool {
+	t := reflect.TypeOf(e)
+	return isHashable(t) && !hasEqualMethod(t)
+}
+
+// find returns the position of the value in counts, or -1.
+func (m *multiset) find(e interface{}) int {
+	if indexable(e) {
+		if i, ok := m.index[e]; ok {
+			return i
+		}
+		return -1
+	}
+	for i, c := range m.counts {
+		if valuesEqual(c.value, e) {
+			return i
+		}
+	}
+	return -1
+}
+
+// add returns the counts of the value, adding it if it wasn't seen before.
+func (m *multiset) add(e interface{}) *elemCount {
+	return &m.counts[m.position(e)]
+}
+
+// position returns the position of the value in counts, adding it if it
+// wasn't seen before.
+func (m *multiset) position(e interface{}) int {
+	i := m.find(e)
+	if i < 0 {
+		i = len(m.counts)
+		m.counts = append(m.counts, elemCount{value: e})
+		if indexable(e) {
+			if m.index == nil {
+				m.index = make(map[interface{}]int)
+			}
+			m.index[e] = i
+		}
 	}
-	return reflect.DeepEqual(mob, mexp), ""
+	return i
+}
+
+// hasEqualMethod checks if t has an `Equal(t) bool` method.
+func hasEqualMethod(t reflect.Type) bool {
+	if t == nil {
+		return false
+	}
+	m, ok := t.MethodByName("Equal")
+	// the receiver is the first argument of a method of a type
+	return ok && m.Type.NumIn() == 2 && t.AssignableTo(m.Type.In(1)) &&
+		m.Type.NumOut() == 1 && m.Type.Out(0).Kind() == reflect.Bool
+}
+
+// valuesEqual compares two values with their `Equal(T) bool` method, if
+// they are of the same type having one, otherwise with reflect.DeepEqual.
+func valuesEqual(a, b interface{}) bool {
+	if t := reflect.TypeOf(a); t == reflect.TypeOf(b) && hasEqualMethod(t) {
+		return reflect.ValueOf(a).MethodByName("Equal").Call([]reflect.Value{reflect.ValueOf(b)})[0].Bool()
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// canHash checks if v can be used as a map key without a panic.
+func canHash(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Func:
+		return false
+	case reflect.Interface:
+		return v.IsNil() || canHash(v.Elem())
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if !canHash(v.Index(i)) {
+				return false
+			}
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if !canHash(v.Field(i)) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// isHashable checks if values of type t can be map keys and if == gives the
+// same result for them as reflect.DeepEqual.
+func isHashable(t reflect.Type) bool {
+	if t == nil {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Func, reflect.Interface, reflect.Ptr:
+		return false
+	case reflect.Array:
+		return isHashable(t.Elem())
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if !isHashable(t.Field(i).Type) {
+				return false
+			}
+		}
+	}
+	return true
 }
 
 // -----------------------------------------------------------------------
 
 type isSorted struct {
 	*gc.CheckerInfo
+	descending, strict bool
+	// less compares two elements; if nil, the sort.Interface of the
+	// container or the natural order of the elements (see GreaterThan) is used.
+	less func(a, b reflect.Value) (bool, string)
 }
 
-// IsSorted checks if given container, implementing `sort.Interface`, is ordered.
+// IsSorted checks if given container is ordered. The container must be a
+// slice or an array of ordered elements (numbers, strings, times, durations or
+// types with a `Compare(T) int` or `Less(T) bool` method), or it must
+// implement `sort.Interface`.
 var IsSorted gc.Checker = &isSorted{
-	&gc.CheckerInfo{Name: "IsSorted", Params: []string{"container"}},
+	CheckerInfo: &gc.CheckerInfo{Name: "IsSorted", Params: []string{"container"}},
+}
+
+// IsSortedDescending checks if given container is ordered from the greatest
+// to the smallest element. See IsSorted for the supported containers.
+var IsSortedDescending gc.Checker = &isSorted{
+	CheckerInfo: &gc.CheckerInfo{Name: "IsSortedDescending", Params: []string{"container"}},
+	descending:  true,
+}
+
+// IsStrictlySorted checks if given container is ordered and doesn't contain
+// duplicates. See IsSorted for the supported containers.
+var IsStrictlySorted gc.Checker = &isSorted{
+	CheckerInfo: &gc.CheckerInfo{Name: "IsStrictlySorted", Params: []string{"container"}},
+	strict:      true,
+}
+
+// IsSortedBy checks if given slice or array of T is ordered according to the
+// less function.
+// For example:
+//
+//	c.Assert(records, IsSortedBy(func(a, b Record) bool { return a.ID < b.ID }))
+func IsSortedBy[T any](less func(a, b T) bool) gc.Checker {
+	return &isSorted{
+		CheckerInfo: &gc.CheckerInfo{Name: "IsSortedBy", Params: []string{"container"}},
+		less: func(a, b reflect.Value) (bool, string) {
+			ta, ok := a.Interface().(T)
+			if !ok {
+				return false, fmt.Sprintf("elements must be %s, got %T", typeOf[T](), a.Interface())
+			}
+			tb, ok := b.Interface().(T)
+			if !ok {
+				return false, fmt.Sprintf("elements must be %s, got %T", typeOf[T](), b.Interface())
+			}
+			return less(ta, tb), ""
+		},
+	}
+}
+
+// IsSortedByKey checks if given slice or array of T is ordered by the keys
+// extracted with the key function. The keys must be ordered values, see
+// IsSorted.
+// For example:
+//
+//	c.Assert(records, IsSortedByKey(func(r Record) time.Time { return r.CreatedAt }))
+func IsSortedByKey[T, K any](key func(T) K) gc.Checker {
+	return &isSorted{
+		CheckerInfo: &gc.CheckerInfo{Name: "IsSortedByKey", Params: []string{"container"}},
+		less: func(a, b reflect.Value) (bool, string) {
+			ta, ok := a.Interface().(T)
+			if !ok {
+				return false, fmt.Sprintf("elements must be %s, got %T", typeOf[T](), a.Interface())
+			}
+			tb, ok := b.Interface().(T)
+			if !ok {
+				return false, fmt.Sprintf("elements must be %s, got %T", typeOf[T](), b.Interface())
+			}
+			cmp, errStr := compare(key(ta), key(tb))
+			return cmp < 0, errStr
+		},
+	}
+}
+
+func typeOf[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
 }
 
 func (checker *isSorted) Check(params []interface{}, names []string) (result bool, error string) {
-	container, ok := params[0].(sort.Interface)
-	if !ok {
-		return false, "value object must implement `sort.Interface`"
+	v := reflect.ValueOf(params[0])
+	container, isSortable := params[0].(sort.Interface)
+	var less func(i, j int) (bool, string)
+	switch {
+	case isSeq(v) && checker.less != nil:
+		less = func(i, j int) (bool, string) { return checker.less(v.Index(i), v.Index(j)) }
+	case isSortable && checker.less == nil:
+		less = func(i, j int) (bool, string) { return container.Less(i, j), "" }
+	case isSeq(v) && checker.less == nil:
+		less = func(i, j int) (bool, string) {
+			cmp, errStr := compare(v.Index(i).Interface(), v.Index(j).Interface())
+			return cmp < 0, errStr
+		}
+	case checker.less != nil:
+		return false, fmt.Sprintf("value must be a slice or an array, got %T", params[0])
+	default:
+		return false, "value object must be a slice, an array or implement `sort.Interface`"
+	}
+
+	var n int
+	if isSeq(v) {
+		n = v.Len()
+	} else {
+		n = container.Len()
 	}
-	for i := 0; i < container.Len()-1; i++ {
-		if container.Less(i+1, i) {
-			return false, fmt.Sprint("value is not ordered at index ", i+1)
+	for i := 1; i < n; i++ {
+		// the pair is out of order if the later element goes first, or, in
+		// the strict mode, if it doesn't go after the earlier one
+		first, second := i, i-1
+		if checker.descending {
+			first, second = i-1, i
+		}
+		outOfOrder, errStr := less(first, second)
+		if errStr == "" && !outOfOrder && checker.strict {
+			var ordered bool
+			ordered, errStr = less(second, first)
+			outOfOrder = !ordered
+		}
+		if errStr != "" {
+			return false, errStr
+		}
+		if outOfOrder {
+			if !isSeq(v) {
+				return false, fmt.Sprint("value is not ordered at index ", i)
+			}
+			return false, fmt.Sprintf("value is not ordered at index %d: [%d] %s, [%d] %s",
+				i, i-1, formatValue(v.Index(i-1)), i, formatValue(v.Index(i)))
 		}
 	}
 	return true, ""
 }
 
 // -----------------------------------------------------------------------
+
+// collectionElems returns the elements of a slice or an array, or the keys of
+// a map (so a map[T]struct{} can be used as a set).
+func collectionElems(v reflect.Value) ([]reflect.Value, reflect.Type, bool) {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		elems := make([]reflect.Value, v.Len())
+		for i := range elems {
+			elems[i] = v.Index(i)
+		}
+		return elems, v.Type().Elem(), true
+	case reflect.Map:
+		return sortedKeys(v), v.Type().Key(), true
+	}
+	return nil, nil, false
+}
+
+type setRelation int
+
+const (
+	containsAll setRelation = iota
+	containsAny
+	containsNone
+	isSubset
+)
+
+type setChecker struct {
+	*gc.CheckerInfo
+	relation setRelation
+	multiset bool
+}
+
+func (c *setChecker) Check(params []interface{}, names []string) (result bool, error string) {
+	obtained, tob, ok := collectionElems(reflect.ValueOf(params[0]))
+	if !ok {
+		return false, fmt.Sprintf("obtained value must be a slice, array or map, got %T", params[0])
+	}
+	expected, texp, ok := collectionElems(reflect.ValueOf(params[1]))
+	if !ok {
+		return false, fmt.Sprintf("%s value must be a slice, array or map, got %T", paramName(c.CheckerInfo, names, 1), params[1])
+	}
+	convert, ok := elemConverter(tob, texp)
+	if !ok {
+		return false, fmt.Sprintf("can't compare elements of type %s with %s", tob, texp)
+	}
+
+	m := multiset{}
+	for _, v := range expected {
+		m.add(convert(v).Interface()).expected++
+	}
+	for _, v := range obtained {
+		m.add(v.Interface()).obtained++
+	}
+
+	d := differ{}
+	common := 0
+	for _, e := range m.counts {
+		if e.obtained > 0 && e.expected > 0 {
+			common++
+		}
+		switch c.relation {
+		case containsAll:
+			if e.obtained < e.expected && (c.multiset || e.obtained == 0) {
+				c.report(&d, e)
+			}
+		case containsNone:
+			if e.obtained > 0 && e.expected > 0 {
+				c.report(&d, e)
+			}
+		case isSubset:
+			if e.obtained > e.expected && (c.multiset || e.expected == 0) {
+				c.report(&d, e)
+			}
+		}
+	}
+	if c.relation == containsAny {
+		if common > 0 {
+			return true, ""
+		}
+		return false, "none of the elements found"
+	}
+	if d.equal() {
+		return true, ""
+	}
+	return false, c.verb() + " elements:" + d.list("elements")
+}
+
+func (c *setChecker) verb() string {
+	if c.relation == containsAll {
+		return "missing"
+	}
+	return "unexpected"
+}
+
+func (c *setChecker) report(d *differ, e elemCount) {
+	if c.multiset {
+		d.reportf("", "%#v (obtained %d, expected %d)", e.value, e.obtained, e.expected)
+	} else {
+		d.reportf("", "%#v", e.value)
+	}
+}
+
+// ContainsAll checks if the obtained slice, array or map keys contain all the
+// elements of the expected slice, array or map keys. Maps can be used as sets,
+// eg map[T]struct{}. Elements are compared with DeepEquals, or with their
+// `Equal(T) bool` method if they have one, like time.Time. Numbers and strings
+// of different types are converted if it is done without loss.
+// For example:
+//
+//	c.Assert(ids, ContainsAll, []int{1, 2, 3})
+var ContainsAll gc.Checker = &setChecker{
+	&gc.CheckerInfo{Name: "ContainsAll", Params: []string{"obtained", "elements"}}, containsAll, false}
+
+// ContainsAny checks if the obtained collection contains at least one of the
+// given elements. See ContainsAll for the supported types.
+var ContainsAny gc.Checker = &setChecker{
+	&gc.CheckerInfo{Name: "ContainsAny", Params: []string{"obtained", "elements"}}, containsAny, false}
+
+// ContainsNone checks if the obtained collection contains none of the given
+// elements. See ContainsAll for the supported types.
+var ContainsNone gc.Checker = &setChecker{
+	&gc.CheckerInfo{Name: "ContainsNone", Params: []string{"obtained", "elements"}}, containsNone, false}
+
+// IsSubsetOf checks if all the elements of the obtained collection are in the
+// expected one. See ContainsAll for the supported types.
+var IsSubsetOf gc.Checker = &setChecker{
+	&gc.CheckerInfo{Name: "IsSubsetOf", Params: []string{"obtained", "superset"}}, isSubset, false}
+
+// IsSupersetOf checks if the obtained collection contains all the elements of
+// the expected one. It's like ContainsAll.
+var IsSupersetOf gc.Checker = &setChecker{
+	&gc.CheckerInfo{Name: "IsSupersetOf", Params: []string{"obtained", "subset"}}, containsAll, false}
+
+// IsDisjointFrom checks if the obtained and the expected collections have no
+// common elements. It's like ContainsNone.
+var IsDisjointFrom gc.Checker = &setChecker{
+	&gc.CheckerInfo{Name: "IsDisjointFrom", Params: []string{"obtained", "other"}}, containsNone, false}
+
+// Multiset returns a version of a set relation checker (ContainsAll,
+// IsSubsetOf, IsSupersetOf, ...) which counts the duplicates. It panics if
+// the checker is not a set relation checker.
+// For example:
+//
+//	c.Assert([]int{1, 1, 2}, Multiset(ContainsAll), []int{1, 1}) // passes
+//	c.Assert([]int{1, 2}, Multiset(ContainsAll), []int{1, 1})    // fails
+func Multiset(checker gc.Checker) gc.Checker {
+	sc, ok := checker.(*setChecker)
+	if !ok {
+		panic(fmt.Sprintf("Multiset supports only the set relation checkers, got %s", checker.Info().Name))
+	}
+	info := *sc.CheckerInfo
+	info.Name = "Multiset(" + info.Name + ")"
+	return &setChecker{&info, sc.relation, true}
+}
+
+// -----------------------------------------------------------------------
+type uniqueChecker struct {
+	*gc.CheckerInfo
+	// key projects the elements before comparing them; if nil, the elements
+	// are compared.
+	key func(elem reflect.Value) (interface{}, string)
+}
+
+func (c *uniqueChecker) Check(params []interface{}, names []string) (result bool, error string) {
+	v := reflect.ValueOf(params[0])
+	if !isSeq(v) {
+		return false, fmt.Sprintf("obtained value must be a slice or an array, got %T", params[0])
+	}
+	m := multiset{}
+	var indices [][]int
+	for i := 0; i < v.Len(); i++ {
+		e := v.Index(i).Interface()
+		if c.key != nil {
+			var errStr string
+			if e, errStr = c.key(v.Index(i)); errStr != "" {
+				return false, errStr
+			}
+		}
+		pos := m.position(e)
+		if pos == len(indices) {
+			indices = append(indices, nil)
+		}
+		indices[pos] = append(indices[pos], i)
+	}
+
+	d := differ{}
+	for pos, idx := range indices {
+		if len(idx) > 1 {
+			d.reportf("", "%#v at indices %v", m.counts[pos].value, idx)
+		}
+	}
+	if d.equal() {
+		return true, ""
+	}
+	return false, "duplicates:" + d.list("duplicates")
+}
+
+// HasUniqueElements checks if the obtained slice or array has no duplicates.
+// Elements are compared with DeepEquals, so they don't need to be comparable,
+// or with their `Equal(T) bool` method if they have one, like time.Time.
+// On failure each duplicated value is reported with all its indices.
+var HasUniqueElements gc.Checker = &uniqueChecker{
+	CheckerInfo: &gc.CheckerInfo{Name: "HasUniqueElements", Params: []string{"obtained"}},
+}
+
+// HasNoDuplicates is an alias for HasUniqueElements
+var HasNoDuplicates = HasUniqueElements
+
+// HasUniqueElementsBy checks if the keys extracted with the key function from
+// the elements of the obtained slice or array of T are unique. The keys are
+// compared like in HasUniqueElements.
+// For example:
+//
+//	c.Assert(users, HasUniqueElementsBy(func(u User) string { return u.Email }))
+func HasUniqueElementsBy[T, K any](key func(T) K) gc.Checker {
+	return &uniqueChecker{
+		CheckerInfo: &gc.CheckerInfo{Name: "HasUniqueElementsBy", Params: []string{"obtained"}},
+		key: func(elem reflect.Value) (interface{}, string) {
+			t, ok := elem.Interface().(T)
+			if !ok {
+				return nil, fmt.Sprintf("elements must be %s, got %T", typeOf[T](), elem.Interface())
+			}
+			return key(t), ""
+		},
+	}
+}
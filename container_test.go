@@ -1,7 +1,9 @@
 package checkers
 
 import (
+	"math"
 	"sort"
+	"time"
 
 	. "gopkg.in/check.v1"
 )
@@ -182,3 +184,413 @@ func (s *ContainerSuite) TestIsSorted(c *C) {
 	c.Check(a, IsSorted)
 
 }
+
+func (s *ContainerSuite) TestSliceEqualsDiff(c *C) {
+	res, msg := SliceEquals.Check([]interface{}{[]int{1, 2, 3, 4}, []int{1, 5, 3}}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "Difference:\n"+
+		"...     [1]: obtained 2, expected 5\n"+
+		"...     [3]: unexpected 4")
+
+	res, msg = SliceEquals.Check([]interface{}{[]int{1}, []int64{1}}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "Difference:\n...     obtained type []int, expected type []int64")
+}
+
+func (s *ContainerSuite) TestMapEqualsDiff(c *C) {
+	type Point struct{ X, Y int }
+	res, msg := MapEquals.Check([]interface{}{
+		map[string]Point{"a": {1, 2}, "b": {1, 2}, "d": {0, 0}},
+		map[string]Point{"a": {1, 2}, "b": {1, 3}, "c": {0, 0}},
+	}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "Difference:\n"+
+		`...     ["b"].Y: obtained 2, expected 3`+"\n"+
+		`...     ["c"]: missing key, expected checkers.Point{X:0, Y:0}`+"\n"+
+		`...     ["d"]: unexpected key, obtained checkers.Point{X:0, Y:0}`)
+}
+
+func (s *ContainerSuite) TestSameContentDiff(c *C) {
+	res, msg := SameContent.Check([]interface{}{[]string{"a", "a", "a", "b"}, []string{"c", "a", "b"}}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "Difference:\n"+
+		`...     "c": 1 missing (obtained 0, expected 1)`+"\n"+
+		`...     "a": 2 extra (obtained 3, expected 1)`)
+}
+
+func (s *ContainerSuite) TestSameContentTime(c *C) {
+	now := time.Now()
+	c.Check([]time.Time{now}, SameContent, []time.Time{now.Round(0)})
+	c.Check([]time.Time{now, now.UTC()}, SameContent, []time.Time{now.Round(0), now})
+	c.Check([]time.Time{now}, Not(SameContent), []time.Time{now.Add(1)})
+	c.Check([]time.Time{now.Round(0)}, ContainsAll, []time.Time{now})
+	c.Check([]time.Time{now, now.Round(0)}, Not(HasUniqueElements))
+}
+
+func (s *ContainerSuite) TestSetRelationsTime(c *C) {
+	utc := time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC)
+	local := utc.In(time.FixedZone("CET", 3600))
+	c.Check([]time.Time{utc}, ContainsAll, []time.Time{local})
+	c.Check([]time.Time{local}, IsSubsetOf, []time.Time{utc, utc.Add(time.Hour)})
+	c.Check([]time.Time{utc}, Not(IsDisjointFrom), []time.Time{local})
+	c.Check([]time.Time{utc}, Not(Multiset(ContainsAll)), []time.Time{utc, local})
+	c.Check([]time.Time{utc, local}, Not(HasUniqueElements))
+	c.Check([]time.Time{utc, local.Add(1)}, HasUniqueElements)
+	c.Check([]record{{1, "a"}, {2, "b"}}, Not(HasUniqueElementsBy(func(r record) time.Time {
+		if r.ID == 1 {
+			return utc
+		}
+		return local
+	})))
+
+	res, msg := HasUniqueElements.Check([]interface{}{[]time.Time{utc, local}}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Matches, `duplicates:\n\.\.\.     time\.Date\(.*\) at indices \[0 1\]`)
+}
+
+func (s *ContainerSuite) TestSameContentNote(c *C) {
+	res, msg := SameContent.Check([]interface{}{[]float64{math.NaN()}, []float64{math.NaN()}}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "Difference:\n"+
+		"...     NaN: 1 missing (obtained 0, expected 1)\n"+
+		"...     NaN: 1 extra (obtained 1, expected 0)\n"+
+		"...     note: the missing and the extra NaN print the same, but they are not equal")
+}
+
+func (s *ContainerSuite) TestSameContentNonComparable(c *C) {
+	type withSlice struct {
+		Name string
+		Tags []string
+	}
+	c.Check([][]int{{1}, {2, 3}, {1}}, SameContent, [][]int{{2, 3}, {1}, {1}})
+	c.Check([]map[string]int{{"a": 1}, {"b": 2}}, SameContent, []map[string]int{{"b": 2}, {"a": 1}})
+	c.Check([]withSlice{{"a", []string{"x"}}, {"b", nil}}, SameContent, []withSlice{{"b", nil}, {"a", []string{"x"}}})
+	c.Check([]interface{}{1, []int{1}, "a"}, SameContent, []interface{}{"a", 1, []int{1}})
+	c.Check([][]int{{1}, {1}}, Not(SameContent), [][]int{{1}, {2}})
+
+	one, two := 1, 2
+	c.Check([]*int{&one, &two}, SameContent, []*int{&two, &one})
+
+	res, msg := SameContent.Check([]interface{}{[][]int{{1}, {1}, {3}}, [][]int{{2}, {1}}}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "Difference:\n"+
+		"...     []int{2}: 1 missing (obtained 0, expected 1)\n"+
+		"...     []int{1}: 1 extra (obtained 2, expected 1)\n"+
+		"...     []int{3}: 1 extra (obtained 1, expected 0)")
+}
+
+func (s *ContainerSuite) TestSameContentConvertible(c *C) {
+	c.Check([3]int{1, 2, 3}, SameContent, []int{3, 2, 1})
+	c.Check([]int{1, 2, 3}, SameContent, [3]int{3, 2, 1})
+	c.Check([]int64{1, 2, 2}, SameContent, []int{2, 1, 2})
+	c.Check([]float64{1, 2}, SameContent, []int{1, 2})
+	c.Check([]float64{1, 2.5}, Not(SameContent), []int{1, 2})
+	c.Check([]int8{1}, Not(SameContent), []int{300})
+	c.Check([]uint{1}, Not(SameContent), []int{-1})
+
+	type name string
+	c.Check([]name{"a", "b"}, SameContent, []string{"b", "a"})
+
+	res, msg := SameContent.Check([]interface{}{[]int{1}, nil}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, `SameContent expects the expected value to be a slice, got "invalid"`)
+}
+
+func (s *ContainerSuite) TestSetRelations(c *C) {
+	ids := []int{1, 2, 3, 3}
+	set := map[int]struct{}{1: {}, 2: {}, 3: {}}
+
+	c.Check(ids, ContainsAll, []int{3, 1})
+	c.Check(ids, ContainsAll, set)
+	c.Check(set, ContainsAll, [2]int64{1, 2})
+	c.Check(ids, Not(ContainsAll), []int{1, 4})
+	c.Check(ids, IsSupersetOf, []int{3, 3, 3})
+	c.Check(ids, ContainsAny, []int{7, 3})
+	c.Check(ids, Not(ContainsAny), []int{})
+	c.Check(ids, ContainsNone, []int{4, 5})
+	c.Check(ids, IsDisjointFrom, map[int]bool{4: true})
+	c.Check(ids, Not(IsDisjointFrom), map[int]bool{1: true})
+	c.Check(ids, IsSubsetOf, set)
+	c.Check([]int{}, IsSubsetOf, []int{})
+	c.Check(ids, Not(IsSubsetOf), []int{1, 2})
+	c.Check([][]int{{1}}, IsSubsetOf, [][]int{{2}, {1}})
+
+	res, msg := ContainsAll.Check([]interface{}{ids, []int{5, 1, 4}}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "missing elements:\n...     5\n...     4")
+
+	res, msg = IsSubsetOf.Check([]interface{}{[]string{"a", "b", "c"}, []string{"b"}}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "unexpected elements:\n...     \"a\"\n...     \"c\"")
+
+	res, msg = ContainsNone.Check([]interface{}{ids, []int{2}}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "unexpected elements:\n...     2")
+
+	res, msg = ContainsAny.Check([]interface{}{ids, []int{5}}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "none of the elements found")
+
+	res, msg = ContainsAll.Check([]interface{}{ids, []string{"1"}}, []string{"obtained", "elements"})
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "can't compare elements of type int with string")
+
+	res, msg = ContainsAll.Check([]interface{}{ids, 1}, []string{"obtained", "elements"})
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "elements value must be a slice, array or map, got int")
+
+	res, msg = ContainsAll.Check([]interface{}{ids, 1}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "elements value must be a slice, array or map, got int")
+
+	c.Check(ids, Not(Bind(IsSubsetOf, 1)))
+	res, msg = Bind(IsSubsetOf, 1).Check([]interface{}{ids}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Matches, ".* value must be a slice, array or map, got int")
+}
+
+func (s *ContainerSuite) TestMultisetRelations(c *C) {
+	c.Check([]int{1, 1, 2}, Multiset(ContainsAll), []int{1, 1})
+	c.Check([]int{1, 2}, Not(Multiset(ContainsAll)), []int{1, 1})
+	c.Check([]int{1, 2}, ContainsAll, []int{1, 1})
+	c.Check([]int{1, 1}, Multiset(IsSubsetOf), []int{1, 1, 2})
+	c.Check([]int{1, 1}, Not(Multiset(IsSubsetOf)), []int{1, 2})
+
+	res, msg := Multiset(IsSupersetOf).Check([]interface{}{[]int{1, 2}, []int{1, 1, 1}}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "missing elements:\n...     1 (obtained 1, expected 3)")
+	c.Check(Multiset(IsSupersetOf).Info().Name, Equals, "Multiset(IsSupersetOf)")
+
+	c.Check(func() { Multiset(Contains) }, PanicMatches, "Multiset supports only the set relation checkers, got Contains")
+}
+
+type stringer string
+
+func (s stringer) String() string { return string(s) }
+
+type myStr string
+
+func (s *ContainerSuite) TestContainsNamedString(c *C) {
+	c.Check(myStr("foobar"), Contains, "oo")
+	c.Check("foobar", Contains, myStr("oo"))
+	c.Check(myStr("foobar"), Contains, myStr("bar"))
+	c.Check(myStr("foobar"), Not(Contains), "baz")
+	c.Check(myStr("oo"), IsIn, "foobar")
+	c.Check("oo", IsIn, myStr("foobar"))
+}
+
+func (s *ContainerSuite) TestContainsConvertible(c *C) {
+	c.Check([]interface{}{1, "a"}, Contains, 1)
+	c.Check([]interface{}{int64(1)}, Contains, 1)
+	c.Check([]interface{}{nil}, Contains, nil)
+	c.Check([]int64{1, 2}, Contains, 1)
+	c.Check([]uint8{1, 2}, Contains, 2)
+	c.Check([]float64{1.5}, Not(Contains), 1)
+	c.Check([]int8{1}, Not(Contains), 300)
+	c.Check(2, IsIn, []int64{1, 2})
+	c.Check([]error{nil}, Contains, nil)
+
+	res, msg := Contains.Check([]interface{}{[]int{1}, "1"}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "value of type string can't be compared with elements of type int")
+
+	res, msg = Contains.Check([]interface{}{[]int{1}, nil}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "elements of type int can't be nil")
+}
+
+func (s *ContainerSuite) TestContainsSubstring(c *C) {
+	c.Check([]byte("foo bar"), Contains, "o b")
+	c.Check([]byte("foo bar"), Contains, []byte("bar"))
+	c.Check([]byte("foo bar"), Contains, 'f')
+	c.Check([]byte("foo bar"), Contains, byte('f'))
+	c.Check([]byte("foo bar"), Not(Contains), "baz")
+	c.Check(stringer("foo bar"), Contains, "bar")
+	c.Check("foo bar", Contains, stringer("bar"))
+	c.Check("foo bar", Contains, 'b')
+
+	res, msg := Contains.Check([]interface{}{"1234", 1}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "value should be a string, []byte or rune, got int")
+}
+
+func (s *ContainerSuite) TestContainsMapAndChan(c *C) {
+	m := map[string]int{"a": 1, "b": 2}
+	c.Check(m, Contains, "a")
+	c.Check(m, Not(Contains), "c")
+	c.Check("b", IsIn, m)
+	c.Check(map[int64]bool{1: true}, Contains, 1)
+	c.Check(map[int8]bool{1: true}, Not(Contains), 300)
+	c.Check(map[interface{}]bool{1: true}, Contains, 1)
+	c.Check(map[interface{}]bool{1: true}, Not(Contains), []int{1})
+	c.Check(map[interface{}]bool{1: true}, Not(Contains), struct{ A interface{} }{[]int{1}})
+	c.Check([]int{1}, Not(IsIn), map[interface{}]bool{1: true})
+	c.Check(map[interface{}]bool{1: true}, Not(HasKey), []int{1})
+	c.Check(map[interface{}]bool{1: true}, Not(HasEntry), []int{1}, true)
+
+	ch := make(chan interface{}, 2)
+	ch <- 1
+	ch <- "a"
+	c.Check(ch, Contains, "a")
+	c.Check(ch, Contains, 1)
+	c.Check(1, IsIn, ch)
+	c.Check(ch, LenAtLeast, 2)
+	c.Check(<-ch, Equals, 1)
+	c.Check(<-ch, Equals, "a")
+
+	ch <- 1
+	recv := (<-chan interface{})(ch)
+	c.Check(recv, Contains, 1)
+	c.Check(recv, Not(Contains), "a")
+	c.Check(len(recv), Equals, 1)
+	<-ch
+
+	res, msg := Contains.Check([]interface{}{(chan<- interface{})(ch), 1}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "can't receive from chan<- interface {}")
+
+	closed := make(chan int, 2)
+	closed <- 1
+	close(closed)
+	res, msg = Contains.Check([]interface{}{closed, 1}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "can't read closed chan int without draining it")
+	c.Check(len(closed), Equals, 0)
+
+	res, msg = Contains.Check([]interface{}{m, 1}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "key of type int can't be compared with keys of type string")
+
+	res, msg = HasEntry.Check([]interface{}{map[interface{}]bool{1: true}, []int{1}, true}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "key []int{1} not found")
+}
+
+func (s *ContainerSuite) TestHasKeyValueEntry(c *C) {
+	m := map[string]int64{"a": 1, "b": 2}
+	c.Check(m, HasKey, "a")
+	c.Check(m, Not(HasKey), "c")
+	c.Check(m, HasValue, 2)
+	c.Check(m, Not(HasValue), 3)
+	c.Check(m, HasEntry, "a", 1)
+	c.Check(m, Not(HasEntry), "a", 2)
+	c.Check(map[string][]int{"a": {1}}, HasEntry, "a", []int{1})
+
+	res, msg := HasEntry.Check([]interface{}{m, "a", 2}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, `key "a" has value 1`)
+
+	res, msg = HasEntry.Check([]interface{}{m, "c", 2}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, `key "c" not found`)
+
+	res, msg = HasValue.Check([]interface{}{m, "x"}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "value of type string can't be compared with elements of type int64")
+
+	res, msg = HasKey.Check([]interface{}{[]int{}, 1}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "obtained value must be a map, got []int")
+}
+
+type byLen []string
+
+func (b byLen) Len() int           { return len(b) }
+func (b byLen) Less(i, j int) bool { return len(b[i]) < len(b[j]) }
+func (b byLen) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
+
+type intHeap struct{ items []int }
+
+func (h *intHeap) Len() int           { return len(h.items) }
+func (h *intHeap) Less(i, j int) bool { return h.items[i] < h.items[j] }
+func (h *intHeap) Swap(i, j int)      { h.items[i], h.items[j] = h.items[j], h.items[i] }
+
+type record struct {
+	ID   int
+	Name string
+}
+
+func (s *ContainerSuite) TestIsSortedSlices(c *C) {
+	c.Check([]int{1, 2, 2, 3}, IsSorted)
+	c.Check([]string{"a", "b"}, IsSorted)
+	c.Check([3]float64{-1, 0, 1.5}, IsSorted)
+	c.Check([]time.Duration{time.Second, time.Minute}, IsSorted)
+	c.Check(byLen{"bb", "a"}, Not(IsSorted))
+	c.Check(byLen{"b", "aa"}, IsSorted)
+	c.Check(&intHeap{[]int{1, 2}}, IsSorted)
+	c.Check(&intHeap{[]int{2, 1}}, Not(IsSorted))
+
+	res, msg := IsSorted.Check([]interface{}{[]int{1, 3, 2}}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "value is not ordered at index 2: [1] 3, [2] 2")
+
+	res, msg = IsSorted.Check([]interface{}{&intHeap{[]int{2, 1}}}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "value is not ordered at index 1")
+
+	res, msg = IsSorted.Check([]interface{}{[]interface{}{1, "a"}}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "can't compare string with int")
+
+	res, msg = IsSorted.Check([]interface{}{1}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "value object must be a slice, an array or implement `sort.Interface`")
+}
+
+func (s *ContainerSuite) TestIsSortedVariants(c *C) {
+	c.Check([]int{3, 2, 2, 1}, IsSortedDescending)
+	c.Check([]int{1, 2}, Not(IsSortedDescending))
+	c.Check([]int{1, 2, 3}, IsStrictlySorted)
+	c.Check([]int{1, 2, 2}, Not(IsStrictlySorted))
+
+	res, msg := IsStrictlySorted.Check([]interface{}{[]string{"a", "b", "b"}}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, `value is not ordered at index 2: [1] "b", [2] "b"`)
+
+	records := []record{{1, "c"}, {2, "b"}, {3, "a"}}
+	c.Check(records, IsSortedBy(func(a, b record) bool { return a.ID < b.ID }))
+	c.Check(records, Not(IsSortedBy(func(a, b record) bool { return a.Name < b.Name })))
+	c.Check(records, IsSortedByKey(func(r record) int { return r.ID }))
+	c.Check(records, Not(IsSortedByKey(func(r record) string { return r.Name })))
+
+	res, msg = IsSortedByKey(func(r record) string { return r.Name }).Check([]interface{}{records}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, `value is not ordered at index 1: [0] checkers.record{ID:1, Name:"c"}, [1] checkers.record{ID:2, Name:"b"}`)
+
+	res, msg = IsSortedBy(func(a, b int) bool { return a < b }).Check([]interface{}{[]string{"a", "b"}}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "elements must be int, got string")
+}
+
+func (s *ContainerSuite) TestHasUniqueElements(c *C) {
+	c.Check([]int{1, 2, 3}, HasUniqueElements)
+	c.Check([]int{}, HasUniqueElements)
+	c.Check([2]string{"a", "b"}, HasNoDuplicates)
+	c.Check([][]int{{1}, {2}}, HasUniqueElements)
+	c.Check([][]int{{1}, {1}}, Not(HasUniqueElements))
+	c.Check([]int{1, 2, 1}, Not(HasUniqueElements))
+
+	res, msg := HasUniqueElements.Check([]interface{}{[]string{"a", "b", "a", "c", "b", "a"}}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "duplicates:\n"+
+		`...     "a" at indices [0 2 5]`+"\n"+
+		`...     "b" at indices [1 4]`)
+
+	res, msg = HasUniqueElements.Check([]interface{}{map[int]int{}}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "obtained value must be a slice or an array, got map[int]int")
+}
+
+func (s *ContainerSuite) TestHasUniqueElementsBy(c *C) {
+	records := []record{{1, "a"}, {2, "b"}, {1, "c"}}
+	c.Check(records, HasUniqueElementsBy(func(r record) string { return r.Name }))
+	c.Check(records, Not(HasUniqueElementsBy(func(r record) int { return r.ID })))
+
+	res, msg := HasUniqueElementsBy(func(r record) int { return r.ID }).Check([]interface{}{records}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "duplicates:\n...     1 at indices [0 2]")
+
+	res, msg = HasUniqueElementsBy(func(r record) int { return r.ID }).Check([]interface{}{[]int{1}}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "elements must be checkers.record, got int")
+}
@@ -0,0 +1,269 @@
+package checkers
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// maxDiffLines limits the number of differences listed in a checker error.
+const maxDiffLines = 20
+
+// differ walks two values side by side, the same way reflect.DeepEqual does,
+// and records a line for every place where they differ. It is the shared diff
+// engine used by the checkers to explain their failures.
+type differ struct {
+	// compare, if set, is called for every pair of values of the same type
+	// before the default comparison. If it handles the pair, it returns true
+	// and reports the differences itself.
+	compare func(d *differ, path string, obtained, expected reflect.Value) bool
+
+	lines   []string
+	count   int
+	visited map[visit]bool
+}
+
+// visit is used to detect cycles while walking pointers.
+type visit struct {
+	a1, a2 uintptr
+	typ    reflect.Type
+}
+
+// reportf records a difference found at the given path.
+func (d *differ) reportf(path, format string, args ...interface{}) {
+	d.count++
+	if len(d.lines) >= maxDiffLines {
+		return
+	}
+	line := fmt.Sprintf(format, args...)
+	if path != "" {
+		line = path + ": " + line
+	}
+	d.lines = append(d.lines, line)
+}
+
+// equal reports whether no difference was recorded.
+func (d *differ) equal() bool {
+	return d.count == 0
+}
+
+// String formats the recorded differences in the same layout gocheck uses
+// for its own "Difference:" output. It returns "" if there are no differences.
+func (d *differ) String() string {
+	if d.count == 0 {
+		return ""
+	}
+	return "Difference:" + d.list("differences")
+}
+
+// list formats the recorded lines, one per gocheck log line. If some of the
+// lines were dropped, it ends with "and N more <what>".
+func (d *differ) list(what string) string {
+	lines := d.lines
+	if more := d.count - len(lines); more > 0 {
+		lines = append(lines, fmt.Sprintf("and %d more %s", more, what))
+	}
+	return "\n...     " + strings.Join(lines, "\n...     ")
+}
+
+// diff compares obtained with expected and records every difference found.
+func (d *differ) diff(path string, obtained, expected reflect.Value) {
+	if !obtained.IsValid() || !expected.IsValid() {
+		if obtained.IsValid() != expected.IsValid() {
+			d.reportf(path, "obtained %s, expected %s", formatValue(obtained), formatValue(expected))
+		}
+		return
+	}
+	if obtained.Type() != expected.Type() {
+		d.reportf(path, "obtained type %s, expected type %s", obtained.Type(), expected.Type())
+		return
+	}
+	if d.compare != nil && d.compare(d, path, obtained, expected) {
+		return
+	}
+
+	switch obtained.Kind() {
+	case reflect.Array:
+		d.diffSeq(path, obtained, expected)
+	case reflect.Slice:
+		if d.diffNil(path, obtained, expected) {
+			return
+		}
+		d.diffSeq(path, obtained, expected)
+	case reflect.Map:
+		if d.diffNil(path, obtained, expected) {
+			return
+		}
+		d.diffMap(path, obtained, expected)
+	case reflect.Struct:
+		t := obtained.Type()
+		for i := 0; i < t.NumField(); i++ {
+			d.diff(path+"."+t.Field(i).Name, obtained.Field(i), expected.Field(i))
+		}
+	case reflect.Ptr:
+		if d.diffNil(path, obtained, expected) || obtained.Pointer() == expected.Pointer() {
+			return
+		}
+		v := visit{obtained.Pointer(), expected.Pointer(), obtained.Type()}
+		if d.visited[v] {
+			return
+		}
+		if d.visited == nil {
+			d.visited = make(map[visit]bool)
+		}
+		d.visited[v] = true
+		d.diff(path, obtained.Elem(), expected.Elem())
+	case reflect.Interface:
+		if d.diffNil(path, obtained, expected) {
+			return
+		}
+		d.diff(path, obtained.Elem(), expected.Elem())
+	default:
+		if !leafEqual(obtained, expected) {
+			d.reportf(path, "obtained %s, expected %s", formatValue(obtained), formatValue(expected))
+		}
+	}
+}
+
+// diffNil records a difference if exactly one of the values is nil. It returns
+// true if there is nothing more to compare.
+func (d *differ) diffNil(path string, obtained, expected reflect.Value) bool {
+	if obtained.IsNil() == expected.IsNil() {
+		return obtained.IsNil()
+	}
+	if obtained.IsNil() {
+		d.reportf(path, "obtained nil, expected %s", formatValue(expected))
+	} else {
+		d.reportf(path, "obtained %s, expected nil", formatValue(obtained))
+	}
+	return true
+}
+
+func (d *differ) diffSeq(path string, obtained, expected reflect.Value) {
+	n := obtained.Len()
+	if expected.Len() < n {
+		n = expected.Len()
+	}
+	for i := 0; i < n; i++ {
+		d.diff(fmt.Sprintf("%s[%d]", path, i), obtained.Index(i), expected.Index(i))
+	}
+	for i := n; i < obtained.Len(); i++ {
+		d.reportf(fmt.Sprintf("%s[%d]", path, i), "unexpected %s", formatValue(obtained.Index(i)))
+	}
+	for i := n; i < expected.Len(); i++ {
+		d.reportf(fmt.Sprintf("%s[%d]", path, i), "missing, expected %s", formatValue(expected.Index(i)))
+	}
+}
+
+func (d *differ) diffMap(path string, obtained, expected reflect.Value) {
+	for _, k := range sortedKeys(expected) {
+		kpath := fmt.Sprintf("%s[%s]", path, formatValue(k))
+		ov := obtained.MapIndex(k)
+		if !ov.IsValid() {
+			d.reportf(kpath, "missing key, expected %s", formatValue(expected.MapIndex(k)))
+			continue
+		}
+		d.diff(kpath, ov, expected.MapIndex(k))
+	}
+	for _, k := range sortedKeys(obtained) {
+		if !expected.MapIndex(k).IsValid() {
+			d.reportf(fmt.Sprintf("%s[%s]", path, formatValue(k)),
+				"unexpected key, obtained %s", formatValue(obtained.MapIndex(k)))
+		}
+	}
+}
+
+// elemCount holds the number of occurrences of a value in the obtained and
+// in the expected multiset.
+type elemCount struct {
+	value              interface{}
+	obtained, expected int
+}
+
+// diffCounts records every element which is over- or under-represented in
+// the obtained multiset. If an extra and a missing element are printed the
+// same, a note tells that they are not equal anyway.
+func (d *differ) diffCounts(counts []elemCount) {
+	extra, missing := map[string]bool{}, map[string]bool{}
+	var same []string
+	for _, c := range counts {
+		s := fmt.Sprintf("%#v", c.value)
+		switch {
+		case c.obtained > c.expected:
+			d.reportf("", "%s: %d extra (obtained %d, expected %d)",
+				s, c.obtained-c.expected, c.obtained, c.expected)
+			extra[s] = true
+		case c.obtained < c.expected:
+			d.reportf("", "%s: %d missing (obtained %d, expected %d)",
+				s, c.expected-c.obtained, c.obtained, c.expected)
+			missing[s] = true
+		default:
+			continue
+		}
+		if extra[s] && missing[s] {
+			same = append(same, s)
+		}
+	}
+	for _, s := range same {
+		d.reportf("", "note: the missing and the extra %s print the same, but they are not equal", s)
+	}
+}
+
+// leafEqual compares two values of the same type which don't contain other
+// values.
+func leafEqual(a, b reflect.Value) bool {
+	switch a.Kind() {
+	case reflect.Bool:
+		return a.Bool() == b.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() == b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() == b.Uint()
+	case reflect.Float32, reflect.Float64:
+		return a.Float() == b.Float()
+	case reflect.Complex64, reflect.Complex128:
+		return a.Complex() == b.Complex()
+	case reflect.String:
+		return a.String() == b.String()
+	case reflect.Chan, reflect.UnsafePointer:
+		return a.Pointer() == b.Pointer()
+	case reflect.Func:
+		// same as reflect.DeepEqual: functions are equal only if both are nil
+		return a.IsNil() && b.IsNil()
+	}
+	return false
+}
+
+func formatValue(v reflect.Value) string {
+	if !v.IsValid() {
+		return "nil"
+	}
+	if v.CanInterface() {
+		return fmt.Sprintf("%#v", v.Interface())
+	}
+	return fmt.Sprintf("%#v", v)
+}
+
+// sortedKeys returns the map keys in a deterministic order.
+func sortedKeys(m reflect.Value) []reflect.Value {
+	keys := m.MapKeys()
+	names := make([]string, len(keys))
+	for i, k := range keys {
+		names[i] = formatValue(k)
+	}
+	sort.Sort(byName{keys, names})
+	return keys
+}
+
+type byName struct {
+	keys  []reflect.Value
+	names []string
+}
+
+func (b byName) Len() int           { return len(b.keys) }
+func (b byName) Less(i, j int) bool { return b.names[i] < b.names[j] }
+func (b byName) Swap(i, j int) {
+	b.keys[i], b.keys[j] = b.keys[j], b.keys[i]
+	b.names[i], b.names[j] = b.names[j], b.names[i]
+}
@@ -0,0 +1,56 @@
+package checkers
+
+import (
+	"fmt"
+	"reflect"
+
+	. "gopkg.in/check.v1"
+)
+
+type DiffSuite struct{}
+
+func diffValues(obtained, expected interface{}) string {
+	d := differ{}
+	d.diff("", reflect.ValueOf(obtained), reflect.ValueOf(expected))
+	return d.String()
+}
+
+func (s *DiffSuite) TestNoDifference(c *C) {
+	type node struct {
+		Next *node
+		Val  []int
+	}
+	n := &node{Val: []int{1}}
+	n.Next = n
+	m := &node{Val: []int{1}}
+	m.Next = m
+	c.Check(diffValues(n, m), Equals, "")
+	c.Check(diffValues(nil, nil), Equals, "")
+	c.Check(diffValues(map[int]int{1: 2}, map[int]int{1: 2}), Equals, "")
+}
+
+func (s *DiffSuite) TestNested(c *C) {
+	type inner struct{ A []string }
+	type outer struct {
+		In  *inner
+		Any interface{}
+		m   map[int]bool
+	}
+	c.Check(diffValues(
+		outer{&inner{[]string{"x"}}, 1, map[int]bool{1: true}},
+		outer{&inner{[]string{"y"}}, "1", nil}),
+		Equals, "Difference:\n"+
+			`...     .In.A[0]: obtained "x", expected "y"`+"\n"+
+			`...     .Any: obtained type int, expected type string`+"\n"+
+			`...     .m: obtained map[int]bool{1:true}, expected nil`)
+}
+
+func (s *DiffSuite) TestTruncated(c *C) {
+	a, b := make([]int, maxDiffLines+5), make([]int, maxDiffLines+5)
+	for i := range b {
+		b[i] = i + 1
+	}
+	msg := diffValues(a, b)
+	c.Check(msg, Matches, "(?s).*\\[19\\]: obtained 0, expected 20\n\\.\\.\\.     and 5 more differences")
+	c.Check(msg, Not(Matches), fmt.Sprintf("(?s).*\\[%d\\].*", maxDiffLines))
+}
@@ -2,15 +2,30 @@
 Package checkers is an extension to github.com/go-check/check library.
 It provides additional usefull checkers:
 
+  * AllOf, AnyOf, NoneOf - combine checkers; use Bind to pass the checkers arguments
   * Between - checks if a number is between given 2 other numbers
-  * Contains (checks if a slice/array/string contains specified element)
+  * Contains (checks if a slice/array/channel/string contains specified element, or a map contains a key)
+  * ContainsInOrder, ContainsSequence, StartsWith, EndsWith - ordered subsequences of slices
   * CloseTo - an alias for EqualsWithTolerance
-  * IsIn (checks if an element is in a slice/array/string)
+  * ContainsAll, ContainsAny, ContainsNone, IsSubsetOf, IsSupersetOf, IsDisjointFrom - set relations of slices, arrays and map keys; see Multiset
+  * IsIn (checks if an element is in a slice/array/channel/string, or is a key of a map)
   * DoesNotExist - checks if a path exists
+  * EachElement, AnyElement, NoElement, CountElements - apply any checker to the elements of a collection
+  * Eventually, Consistently - poll a func() T with any checker until a timeout
   * EqualsWithTolerance - checks if two numbers are "close enough"
+  * DeepCloseTo - like DeepEquals, but compares floats with a tolerance
+  * EqualsWith - configurable DeepEquals (ignored fields, custom comparers, sorted slices, ...)
+  * PartiallyEquals - compares only the non-zero fields of the expected value
+  * HasFields - compares the values at the given field paths, eg "Address.City"
+  * RelativelyCloseTo, IsClose, WithinULPs, CloseWithin - relative, combined and ULP based float comparisons
+  * GreaterThan, GreaterOrEqual (AtLeast), LessThan, LessOrEqual (AtMost) - ordering of numbers, strings, times and durations
+  * HasKey, HasValue, HasEntry - map lookups
   * HasPrefix, HasSuffix
   * IsDirectory
+  * IsSorted, IsSortedDescending, IsStrictlySorted, IsSortedBy, IsSortedByKey - ordering of slices
+  * HasUniqueElements (HasNoDuplicates), HasUniqueElementsBy - checks if a slice has no duplicates
   * IsEmpty - checks if specified object is empty (nil, [], {}, "", 0)
+  * LenBetween, LenAtLeast, LenAtMost, CapAtLeast - length and capacity ranges of collections
   * IsNonEmptyFile
   * IsSymlink, SymlinkDoesNotExist
   * IsTrue, IsFalse
@@ -20,13 +35,25 @@ It provides additional usefull checkers:
   * Satisfies - check if a value satisfies functional predicate
   * SliceEquals - checks if 2 slices contain the same elements
   * StrEquals - checks if fmt.Sprint values of objects are equal
+  * Transform, Field - check a value derived from the obtained one, eg a nested field
   * TimeEquals - checks if time is the same up to microseconds, useful if some driver or type truncates the nanosecond time accuracy.
-  * WithinDuration - checks if an obtained time is not earlier/later than the expected time + duration
-  * DurationLessThan
+  * TimeEqualsAt - checks if times are equal at a given precision, with truncate or round modes and optional location and monotonic clock comparison
+  * WithinDuration - checks if an obtained time is not earlier/later than the expected time + duration; the time checkers accept RFC 3339 strings, Unix timestamps (Unix), custom layouts (ParseTime) and encoding.TextUnmarshaler timestamps
+  * IsRecent, IsInFuture - check a time against the DefaultClock; see FakeClock and FromNow for times relative to it
+  * DurationLessThan, DurationGreaterThan, DurationBetween, DurationCloseTo - compare durations, also given as strings like "1m30s" or integer nanoseconds
+  * CompletesWithin - checks if a func() returns within a duration
 
 Furthermore there are two additional CommentInterface implementations:
 
   * Comment - works like fmt.Sprint (doesn't have a formatting string)
   * CommentSpew - like Commentf, but uses Spew to format the structures.
+
+All checkers can be used without the gocheck runner, in plain `go test`
+functions, through the Check and Assert functions:
+
+  checkers.Assert(t, obtained, checkers.SameContent, expected)
+
+Type-safe, generic versions of the checkers live in the
+github.com/robert-zaremba/checkers/typed package.
 */
 package checkers
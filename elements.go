@@ -0,0 +1,188 @@
+package checkers
+
+import (
+	"fmt"
+	"reflect"
+
+	gc "gopkg.in/check.v1"
+)
+
+// element is a member of a collection, labeled with its position.
+type element struct {
+	label string
+	value interface{}
+}
+
+// elements lists the elements of a slice, array, map (values, ordered by
+// key), channel (the buffered values, see chanValues) or string (runes).
+func elements(container interface{}) ([]element, string) {
+	cv := reflect.ValueOf(container)
+	var elems []element
+	switch cv.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < cv.Len(); i++ {
+			elems = append(elems, element{fmt.Sprintf("[%d]", i), cv.Index(i).Interface()})
+		}
+	case reflect.Map:
+		for _, k := range sortedKeys(cv) {
+			elems = append(elems, element{fmt.Sprintf("[%s]", formatValue(k)), cv.MapIndex(k).Interface()})
+		}
+	case reflect.Chan:
+		values, errStr := chanValues(cv)
+		if errStr != "" {
+			return nil, errStr
+		}
+		for i, v := range values {
+			elems = append(elems, element{fmt.Sprintf("[%d]", i), v.Interface()})
+		}
+	case reflect.String:
+		for i, r := range []rune(cv.String()) {
+			elems = append(elems, element{fmt.Sprintf("[%d]", i), r})
+		}
+	default:
+		return nil, fmt.Sprintf("obtained value must be a slice, array, map, channel or string, got %T", container)
+	}
+	return elems, ""
+}
+
+// chanValues receives the buffered values of a channel and sends them back in
+// the same order. A receive-only channel is read through a bidirectional view
+// of it. The values of a closed channel can't be sent back, so they are
+// consumed and an error is returned.
+func chanValues(cv reflect.Value) ([]reflect.Value, string) {
+	t := cv.Type()
+	switch t.ChanDir() {
+	case reflect.SendDir:
+		return nil, fmt.Sprintf("can't receive from %s", t)
+	case reflect.RecvDir:
+		p := reflect.New(t)
+		p.Elem().Set(cv)
+		cv = reflect.NewAt(reflect.ChanOf(reflect.BothDir, t.Elem()), p.UnsafePointer()).Elem()
+	}
+	values := make([]reflect.Value, 0, cv.Len())
+	for n := cv.Len(); len(values) < n; {
+		v, ok := cv.TryRecv()
+		if !ok {
+			break
+		}
+		values = append(values, v)
+	}
+	if !sendBack(cv, values) {
+		return nil, fmt.Sprintf("can't read closed %s without draining it", t)
+	}
+	return values, ""
+}
+
+// sendBack sends the values to a channel, returning false if it is closed.
+func sendBack(cv reflect.Value, values []reflect.Value) (ok bool) {
+	defer func() {
+		if recover() != nil {
+			ok = false
+		}
+	}()
+	for _, v := range values {
+		cv.Send(v)
+	}
+	return true
+}
+
+// -----------------------------------------------------------------------
+type quantifier struct {
+	name    string
+	checker gc.Checker
+	// accept decides the result based on the number of the elements passing
+	// the check. On failure, the elements passing are explained if
+	// showPassed returns true, otherwise the failing ones.
+	accept     func(passed, total int) bool
+	showPassed func(passed int) bool
+	expected   string
+}
+
+func (q *quantifier) Info() *gc.CheckerInfo {
+	return &gc.CheckerInfo{
+		Name:   fmt.Sprintf("%s(%s)", q.name, q.checker.Info().Name),
+		Params: []string{"obtained"},
+	}
+}
+
+func (q *quantifier) Check(params []interface{}, names []string) (result bool, error string) {
+	elems, errStr := elements(params[0])
+	if errStr != "" {
+		return false, errStr
+	}
+	passed := 0
+	d := differ{}
+	results := make([]bool, len(elems))
+	errors := make([]string, len(elems))
+	for i, e := range elems {
+		results[i], errors[i] = checkOne(q.checker, e.value)
+		if results[i] {
+			passed++
+		}
+	}
+	if q.accept(passed, len(elems)) {
+		return true, ""
+	}
+	showPassed := q.showPassed(passed)
+	for i, e := range elems {
+		switch {
+		case results[i] != showPassed:
+			continue
+		case results[i]:
+			d.reportf(e.label, "%#v: passed", e.value)
+		case errors[i] == "":
+			d.reportf(e.label, "%#v: failed", e.value)
+		default:
+			d.reportf(e.label, "%#v: %s", e.value, indent(errors[i]))
+		}
+	}
+	msg := fmt.Sprintf("%d of %d elements passed %s, expected %s",
+		passed, len(elems), q.checker.Info().Name, q.expected)
+	if d.count == 0 {
+		return false, msg
+	}
+	return false, msg + ":" + d.list("elements")
+}
+
+// EachElement checks if every element of the obtained slice, array, map
+// (values), channel or string (runes) passes the checker with the given
+// arguments. The buffered values of a channel are received and sent back in
+// the same order, so the channel must not be used concurrently; a closed
+// channel is drained and reported as an error. It panics if
+// the number of the arguments doesn't match the checker.
+// For example:
+//
+//	c.Assert(filenames, EachElement(HasSuffix, ".log"))
+func EachElement(checker gc.Checker, args ...interface{}) gc.Checker {
+	return &quantifier{"EachElement", bindChecker(checker, args),
+		func(passed, total int) bool { return passed == total },
+		func(int) bool { return false }, "all"}
+}
+
+// AnyElement checks if at least one element of the obtained collection passes
+// the checker with the given arguments. See EachElement.
+func AnyElement(checker gc.Checker, args ...interface{}) gc.Checker {
+	return &quantifier{"AnyElement", bindChecker(checker, args),
+		func(passed, total int) bool { return passed > 0 },
+		func(int) bool { return false }, "at least 1"}
+}
+
+// NoElement checks if no element of the obtained collection passes the
+// checker with the given arguments. See EachElement.
+func NoElement(checker gc.Checker, args ...interface{}) gc.Checker {
+	return &quantifier{"NoElement", bindChecker(checker, args),
+		func(passed, total int) bool { return passed == 0 },
+		func(int) bool { return true }, "none"}
+}
+
+// CountElements checks if exactly n elements of the obtained collection pass
+// the checker with the given arguments. It panics if n is negative.
+// See EachElement.
+func CountElements(n int, checker gc.Checker, args ...interface{}) gc.Checker {
+	if n < 0 {
+		panic(fmt.Sprintf("CountElements: n must not be negative, got %d", n))
+	}
+	return &quantifier{"CountElements", bindChecker(checker, args),
+		func(passed, total int) bool { return passed == n },
+		func(passed int) bool { return passed > n }, fmt.Sprint(n)}
+}
@@ -0,0 +1,76 @@
+package checkers
+
+import (
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+type ElementsSuite struct{}
+
+func (s *ElementsSuite) TestEachElement(c *C) {
+	now := time.Now()
+	c.Check([]time.Time{now, now.Add(time.Second)}, EachElement(WithinDuration, now, time.Minute))
+	c.Check([3]int{1, 2, 3}, EachElement(Between, 1, 3))
+	c.Check(map[string]int{"a": 1, "b": 0}, Not(EachElement(Between, 1, 3)))
+	c.Check("abc", EachElement(Satisfies, func(r rune) bool { return r >= 'a' }))
+	c.Check([]string{}, EachElement(IsEmpty))
+
+	res, msg := EachElement(HasSuffix, ".log").Check([]interface{}{[]interface{}{"a.log", "b.txt", 1}}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, `1 of 3 elements passed HasSuffix(".log"), expected all:`+"\n"+
+		`...     [1]: "b.txt": failed`+"\n"+
+		`...     [2]: 1: Obtained value is not a string and has no .String()`)
+
+	res, msg = EachElement(IsTrue).Check([]interface{}{1}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "obtained value must be a slice, array, map, channel or string, got int")
+}
+
+func (s *ElementsSuite) TestAnyElement(c *C) {
+	c.Check([]string{"a.txt", "b.log"}, AnyElement(HasSuffix, ".log"))
+	c.Check([]string{}, Not(AnyElement(IsEmpty)))
+
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 7
+	c.Check(ch, AnyElement(Between, 5, 10))
+	c.Check(ch, EachElement(Between, 1, 7))
+	c.Check(len(ch), Equals, 2)
+
+	closed := make(chan int, 2)
+	closed <- 1
+	close(closed)
+	res, msg := EachElement(IsTrue).Check([]interface{}{closed}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "can't read closed chan int without draining it")
+
+	res, msg = AnyElement(Between, 5, 10).Check([]interface{}{map[string]int{"a": 1, "b": 2}}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "0 of 2 elements passed Between(5, 10), expected at least 1:\n"+
+		`...     ["a"]: 1: failed`+"\n"+
+		`...     ["b"]: 2: failed`)
+}
+
+func (s *ElementsSuite) TestNoElement(c *C) {
+	c.Check([]int{1, 2}, NoElement(Between, 5, 10))
+
+	res, msg := NoElement(IsEmpty).Check([]interface{}{[]string{"a", "", "b"}}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "1 of 3 elements passed IsEmpty, expected none:\n"+
+		`...     [1]: "": passed`)
+}
+
+func (s *ElementsSuite) TestCountElements(c *C) {
+	c.Check([]int{1, 7, 8}, CountElements(2, Between, 5, 10))
+	c.Check([]int{1, 7, 8}, Not(CountElements(1, Between, 5, 10)))
+	c.Check([]int{}, CountElements(0, IsTrue))
+
+	res, msg := CountElements(2, IsEmpty).Check([]interface{}{[]int{0, 1}}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "1 of 2 elements passed IsEmpty, expected 2:\n...     [1]: 1: failed")
+
+	c.Check(func() { CountElements(-1, IsEmpty) }, PanicMatches, "CountElements: n must not be negative, got -1")
+	c.Check(func() { CountElements(0, Between) }, PanicMatches, "Wrong number of parameters for Between: want 2, got 0")
+	c.Check(func() { Not(EachElement(Between, 4)) }, PanicMatches, "Wrong number of parameters for Between: want 2, got 1")
+}
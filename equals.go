@@ -2,7 +2,10 @@ package checkers
 
 import (
 	"fmt"
+	"math"
+	"math/cmplx"
 	"reflect"
+	"sort"
 
 	gc "gopkg.in/check.v1"
 )
@@ -44,3 +47,371 @@ func isNil(obtained interface{}) (result bool) {
 	}
 	return
 }
+
+// -----------------------------------------------------------------------
+type deepCloseTo struct {
+	*gc.CheckerInfo
+}
+
+func (c *deepCloseTo) Check(params []interface{}, names []string) (result bool, error string) {
+	tolerance, errStr := toFloat(params[2])
+	if errStr != "" {
+		return false, "Wrong tolerance value: " + errStr
+	}
+	d := differ{compare: func(d *differ, path string, obtained, expected reflect.Value) bool {
+		switch obtained.Kind() {
+		case reflect.Float32, reflect.Float64:
+			a, b := obtained.Float(), expected.Float()
+			if !equalWithTolerance(a, b, tolerance) {
+				d.reportf(path, "obtained %s, expected %s (absolute error %g)",
+					formatValue(obtained), formatValue(expected), math.Abs(a-b))
+			}
+			return true
+		case reflect.Complex64, reflect.Complex128:
+			a, b := obtained.Complex(), expected.Complex()
+			if a != b && !(cmplx.Abs(a-b) <= tolerance) {
+				d.reportf(path, "obtained %s, expected %s (absolute error %g)",
+					formatValue(obtained), formatValue(expected), cmplx.Abs(a-b))
+			}
+			return true
+		}
+		return false
+	}}
+	d.diff("", reflect.ValueOf(params[0]), reflect.ValueOf(params[1]))
+	return d.equal(), d.String()
+}
+
+// DeepCloseTo walks both values the way reflect.DeepEqual does, but compares
+// every float and complex leaf with EqualsWithTolerance semantics. On failure
+// the paths of the mismatching leaves are reported, eg `.Points[3].Y`.
+// For example:
+//
+//	c.Assert(obtained, DeepCloseTo, expected, 1e-9)
+var DeepCloseTo gc.Checker = &deepCloseTo{
+	&gc.CheckerInfo{Name: "DeepCloseTo", Params: []string{"obtained", "expected", "tolerance"}}}
+
+// -----------------------------------------------------------------------
+
+// EqualOption configures the comparison done by EqualsWith.
+type EqualOption func(*equalOptions)
+
+type equalOptions struct {
+	err              string
+	ignoreFields     map[reflect.Type]map[string]bool
+	ignoreUnexported bool
+	equateEmpty      bool
+	useEqualMethod   bool
+	sortSlices       map[reflect.Type]reflect.Value
+	comparers        map[reflect.Type]reflect.Value
+}
+
+func (o *equalOptions) errorf(format string, args ...interface{}) {
+	if o.err == "" {
+		o.err = fmt.Sprintf(format, args...)
+	}
+}
+
+// Options groups several options into one, so option sets can be reused.
+func Options(opts ...EqualOption) EqualOption {
+	return func(o *equalOptions) {
+		for _, opt := range opts {
+			opt(o)
+		}
+	}
+}
+
+// IgnoreFields ignores the named fields of the struct type of typ. A field
+// promoted from an embedded struct is ignored in that struct type, wherever
+// it's used.
+// For example:
+//
+//	IgnoreFields(User{}, "ID", "CreatedAt")
+func IgnoreFields(typ interface{}, names ...string) EqualOption {
+	return func(o *equalOptions) {
+		t := reflect.TypeOf(typ)
+		if t == nil || t.Kind() != reflect.Struct {
+			o.errorf("IgnoreFields expects a struct value, got %T", typ)
+			return
+		}
+		if o.ignoreFields == nil {
+			o.ignoreFields = make(map[reflect.Type]map[string]bool)
+		}
+		for _, name := range names {
+			f, ok := t.FieldByName(name)
+			if !ok {
+				o.errorf("IgnoreFields: %s has no field %s", t, name)
+				return
+			}
+			decl := t
+			for _, i := range f.Index[:len(f.Index)-1] {
+				if decl = decl.Field(i).Type; decl.Kind() == reflect.Ptr {
+					decl = decl.Elem()
+				}
+			}
+			if o.ignoreFields[decl] == nil {
+				o.ignoreFields[decl] = make(map[string]bool)
+			}
+			o.ignoreFields[decl][name] = true
+		}
+	}
+}
+
+// IgnoreUnexported ignores the unexported fields of all structs.
+func IgnoreUnexported() EqualOption {
+	return func(o *equalOptions) { o.ignoreUnexported = true }
+}
+
+// EquateEmpty treats nil and empty slices and maps as equal.
+func EquateEmpty() EqualOption {
+	return func(o *equalOptions) { o.equateEmpty = true }
+}
+
+// UseEqualMethod compares values with their `Equal(T) bool` method, when they
+// have one (eg time.Time).
+func UseEqualMethod() EqualOption {
+	return func(o *equalOptions) { o.useEqualMethod = true }
+}
+
+// SortSlices sorts all slices of T with the `less func(a, b T) bool` function
+// before comparing them, so the order of their elements doesn't matter.
+func SortSlices(less interface{}) EqualOption {
+	return func(o *equalOptions) {
+		f := reflect.ValueOf(less)
+		if !isFunc(f, 2, reflect.Bool) || f.Type().In(0) != f.Type().In(1) {
+			o.errorf("SortSlices expects func(T, T) bool, got %T", less)
+			return
+		}
+		if o.sortSlices == nil {
+			o.sortSlices = make(map[reflect.Type]reflect.Value)
+		}
+		o.sortSlices[f.Type().In(0)] = f
+	}
+}
+
+// Comparer compares all values of type T with the `equal func(a, b T) bool`
+// function.
+func Comparer(equal interface{}) EqualOption {
+	return func(o *equalOptions) {
+		f := reflect.ValueOf(equal)
+		if !isFunc(f, 2, reflect.Bool) || f.Type().In(0) != f.Type().In(1) {
+			o.errorf("Comparer expects func(T, T) bool, got %T", equal)
+			return
+		}
+		if o.comparers == nil {
+			o.comparers = make(map[reflect.Type]reflect.Value)
+		}
+		o.comparers[f.Type().In(0)] = f
+	}
+}
+
+// isFunc checks if f is a function with numIn arguments, returning a single
+// value of the given kind.
+func isFunc(f reflect.Value, numIn int, out reflect.Kind) bool {
+	return f.Kind() == reflect.Func && f.Type().NumIn() == numIn &&
+		f.Type().NumOut() == 1 && f.Type().Out(0).Kind() == out
+}
+
+// compare implements the differ.compare hook.
+func (o *equalOptions) compare(d *differ, path string, obtained, expected reflect.Value) bool {
+	t := obtained.Type()
+	canCall := obtained.CanInterface() && expected.CanInterface()
+
+	if f, ok := o.comparers[t]; ok && canCall {
+		if !f.Call([]reflect.Value{obtained, expected})[0].Bool() {
+			d.reportf(path, "obtained %s, expected %s", formatValue(obtained), formatValue(expected))
+		}
+		return true
+	}
+	if o.useEqualMethod && compareByEqual(d, path, obtained, expected) {
+		return true
+	}
+
+	switch t.Kind() {
+	case reflect.Slice, reflect.Map:
+		if o.equateEmpty && obtained.Len() == 0 && expected.Len() == 0 {
+			return true
+		}
+		if less, ok := o.sortSlices[t.Elem()]; ok && t.Kind() == reflect.Slice && canCall &&
+			!obtained.IsNil() && !expected.IsNil() {
+			d.diffSeq(path, sortedCopy(obtained, less), sortedCopy(expected, less))
+			return true
+		}
+	case reflect.Struct:
+		ignored := o.ignoreFields[t]
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if ignored[f.Name] || o.ignoreUnexported && f.PkgPath != "" {
+				continue
+			}
+			d.diff(path+"."+f.Name, obtained.Field(i), expected.Field(i))
+		}
+		return true
+	}
+	return false
+}
+
+// compareByEqual compares the values with the `Equal(T) bool` method of the
+// obtained one, like time.Time.Equal, if it has one. It implements the
+// differ.compare hook.
+func compareByEqual(d *differ, path string, obtained, expected reflect.Value) bool {
+	if !obtained.CanInterface() || !expected.CanInterface() {
+		return false
+	}
+	m := obtained.MethodByName("Equal")
+	if !isMethod(m, obtained.Type(), reflect.Bool) {
+		return false
+	}
+	if !m.Call([]reflect.Value{expected})[0].Bool() {
+		d.reportf(path, "obtained %s, expected %s", formatValue(obtained), formatValue(expected))
+	}
+	return true
+}
+
+// sortedCopy returns a sorted copy of the slice s.
+func sortedCopy(s reflect.Value, less reflect.Value) reflect.Value {
+	c := reflect.MakeSlice(s.Type(), s.Len(), s.Len())
+	reflect.Copy(c, s)
+	sort.SliceStable(c.Interface(), func(i, j int) bool {
+		return less.Call([]reflect.Value{c.Index(i), c.Index(j)})[0].Bool()
+	})
+	return c
+}
+
+type equalsWith struct {
+	expected interface{}
+	opts     equalOptions
+}
+
+// EqualsWith returns a checker which compares the obtained value with expected
+// like reflect.DeepEqual does, but configured with the given options.
+// On failure, a diff annotated with the paths of the differences is reported.
+// For example:
+//
+//	c.Assert(user, EqualsWith(expected, IgnoreFields(User{}, "ID"), EquateEmpty()))
+func EqualsWith(expected interface{}, opts ...EqualOption) gc.Checker {
+	c := &equalsWith{expected: expected}
+	Options(opts...)(&c.opts)
+	return c
+}
+
+func (c *equalsWith) Info() *gc.CheckerInfo {
+	return &gc.CheckerInfo{Name: "EqualsWith", Params: []string{"obtained"}}
+}
+
+func (c *equalsWith) Check(params []interface{}, names []string) (result bool, error string) {
+	if c.opts.err != "" {
+		return false, c.opts.err
+	}
+	d := differ{compare: c.opts.compare}
+	d.diff("", reflect.ValueOf(params[0]), reflect.ValueOf(c.expected))
+	return d.equal(), d.String()
+}
+
+// -----------------------------------------------------------------------
+type partiallyEquals struct {
+	*gc.CheckerInfo
+}
+
+// comparePartially implements the differ.compare hook for PartiallyEquals.
+func comparePartially(d *differ, path string, obtained, expected reflect.Value) bool {
+	if expected.IsZero() {
+		return true
+	}
+	if compareByEqual(d, path, obtained, expected) {
+		return true
+	}
+	if expected.Kind() != reflect.Map {
+		return false
+	}
+	if obtained.IsNil() {
+		d.reportf(path, "obtained nil, expected %s", formatValue(expected))
+		return true
+	}
+	for _, k := range sortedKeys(expected) {
+		kpath := fmt.Sprintf("%s[%s]", path, formatValue(k))
+		ov := obtained.MapIndex(k)
+		if !ov.IsValid() {
+			d.reportf(kpath, "missing key, expected %s", formatValue(expected.MapIndex(k)))
+			continue
+		}
+		d.diff(kpath, ov, expected.MapIndex(k))
+	}
+	return true
+}
+
+func (c *partiallyEquals) Check(params []interface{}, names []string) (result bool, error string) {
+	d := differ{compare: comparePartially}
+	d.diff("", reflect.ValueOf(params[0]), reflect.ValueOf(params[1]))
+	return d.equal(), d.String()
+}
+
+// PartiallyEquals checks if the obtained value matches the non-zero parts of
+// the expected value. Zero fields of the expected structs are ignored, keys
+// missing in the expected maps are ignored, and both rules are applied
+// recursively through nested structs, pointers, slices and maps. Hence a
+// field can't be checked to be zero with PartiallyEquals. Values with an
+// `Equal(T) bool` method, like time.Time, are compared with it.
+// For example:
+//
+//	c.Assert(resp, PartiallyEquals, Response{Name: "x", Address: Address{City: "y"}})
+var PartiallyEquals gc.Checker = &partiallyEquals{
+	&gc.CheckerInfo{Name: "PartiallyEquals", Params: []string{"obtained", "expected"}}}
+
+// -----------------------------------------------------------------------
+type hasFields struct {
+	*gc.CheckerInfo
+}
+
+func (c *hasFields) Check(params []interface{}, names []string) (result bool, error string) {
+	fields, ok := params[1].(map[string]interface{})
+	if !ok {
+		return false, "expected value type must be map[string]interface{}"
+	}
+	paths := make([]string, 0, len(fields))
+	for path := range fields {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	d := differ{compare: compareByEqual}
+	obtained := reflect.ValueOf(params[0])
+	for _, path := range paths {
+		v, errStr := lookupPath(obtained, path)
+		if errStr != "" {
+			d.reportf(path, "%s", errStr)
+			continue
+		}
+		if v.Kind() == reflect.Interface && !v.IsNil() {
+			v = v.Elem()
+		}
+		expected := reflect.ValueOf(fields[path])
+		if expected.IsValid() && expected.Kind() != reflect.Ptr && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				d.reportf(path, "obtained nil %s, expected %s", v.Type(), formatValue(expected))
+				continue
+			}
+			v = v.Elem()
+		}
+		if expected.IsValid() {
+			if ev, ok := convertTo(expected, v.Type()); ok {
+				expected = ev
+			}
+		} else if canBeNil(v.Type()) && v.IsNil() {
+			continue
+		}
+		d.diff(path, v, expected)
+	}
+	return d.equal(), d.String()
+}
+
+// HasFields checks if the obtained value has the given values at the given
+// paths. A path lists struct field names or map keys separated with dots and
+// can select slice or array elements, eg `Items[2].Price`. A pointer field is
+// dereferenced unless the expected value is a pointer. Numbers and strings
+// are converted to the type of the field if it is done without loss, and
+// values with an `Equal(T) bool` method, like time.Time, are compared with it.
+// For example:
+//
+//	c.Assert(resp, HasFields, map[string]interface{}{"Name": "x", "Address.City": "y"})
+var HasFields gc.Checker = &hasFields{
+	&gc.CheckerInfo{Name: "HasFields", Params: []string{"obtained", "fields"}}}
@@ -0,0 +1,247 @@
+package checkers
+
+import (
+	"math"
+	"math/big"
+	"strings"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+type EqualsSuite struct{}
+
+func (s *EqualsSuite) TestStrEquals(c *C) {
+	c.Check(1, StrEquals, "1")
+	c.Check(nil, StrEquals, nil)
+	c.Check(1, Not(StrEquals), nil)
+}
+
+type point struct{ X, Y float64 }
+
+type shape struct {
+	Name   string
+	Points []point
+	Attrs  map[string]complex128
+}
+
+func (s *EqualsSuite) TestDeepCloseTo(c *C) {
+	c.Check(1.0, DeepCloseTo, 1.05, 0.1)
+	c.Check([]float32{1, 2}, DeepCloseTo, []float32{1.01, 1.99}, 0.1)
+	c.Check(
+		&shape{"a", []point{{0, 1}}, map[string]complex128{"z": 1i}}, DeepCloseTo,
+		&shape{"a", []point{{1e-10, 1}}, map[string]complex128{"z": 1i + 1e-10}}, 1e-9)
+	c.Check(math.Inf(1), DeepCloseTo, math.Inf(1), 0)
+	c.Check([]float64{1}, Not(DeepCloseTo), []float64{1, 2}, 1)
+	c.Check(shape{Name: "a"}, Not(DeepCloseTo), shape{Name: "b"}, 1)
+
+	res, msg := DeepCloseTo.Check([]interface{}{
+		shape{"a", []point{{0, 1}, {2, 3}}, map[string]complex128{"z": 1}},
+		shape{"a", []point{{0, 1}, {2, 3.5}}, map[string]complex128{"z": 2}},
+		0.1}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "Difference:\n"+
+		"...     .Points[1].Y: obtained 3, expected 3.5 (absolute error 0.5)\n"+
+		`...     .Attrs["z"]: obtained (1+0i), expected (2+0i) (absolute error 1)`)
+
+	res, msg = DeepCloseTo.Check([]interface{}{1.0, 1.0, "x"}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "Wrong tolerance value: Expecting a number, got: string")
+}
+
+type user struct {
+	ID        int
+	Name      string
+	Tags      []string
+	CreatedAt time.Time
+	cache     map[string]int
+}
+
+func (s *EqualsSuite) TestEqualsWith(c *C) {
+	now := time.Now()
+	u1 := user{1, "bob", nil, now, map[string]int{"a": 1}}
+	u2 := user{2, "bob", []string{}, now.UTC(), nil}
+
+	c.Check(u1, Not(EqualsWith(u2)))
+	c.Check(u1, EqualsWith(u2,
+		IgnoreFields(user{}, "ID"), IgnoreUnexported(), EquateEmpty(), UseEqualMethod()))
+
+	volatile := Options(IgnoreFields(user{}, "ID", "CreatedAt"), IgnoreUnexported())
+	c.Check(u1, EqualsWith(u2, volatile, EquateEmpty()))
+	c.Check(u1, Not(EqualsWith(u2, volatile)))
+
+	res, msg := EqualsWith(u2, volatile).Check([]interface{}{u1}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "Difference:\n...     .Tags: obtained nil, expected []string{}")
+}
+
+type (
+	entity struct{ ID int }
+	named  struct {
+		*entity
+		Name string
+	}
+	account struct {
+		named
+		Balance int
+	}
+)
+
+func (s *EqualsSuite) TestEqualsWithPromotedFields(c *C) {
+	a1 := account{named{&entity{3}, "bob"}, 10}
+	a2 := account{named{&entity{1}, "bob"}, 10}
+	c.Check(a1, Not(EqualsWith(a2)))
+	c.Check(a1, EqualsWith(a2, IgnoreFields(account{}, "ID")))
+	c.Check(a1.named, EqualsWith(a2.named, IgnoreFields(named{}, "ID")))
+
+	res, msg := EqualsWith(a2, IgnoreFields(account{}, "ID")).Check([]interface{}{account{a1.named, 20}}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "Difference:\n...     .Balance: obtained 20, expected 10")
+}
+
+func (s *EqualsSuite) TestEqualsWithSortAndComparer(c *C) {
+	byName := func(a, b string) bool { return a < b }
+	u1 := user{Name: "Bob", Tags: []string{"b", "a"}}
+	u2 := user{Name: "bob", Tags: []string{"a", "b"}}
+	c.Check(u1, Not(EqualsWith(u2, SortSlices(byName))))
+	c.Check(u1, EqualsWith(u2, SortSlices(byName), Comparer(strings.EqualFold)))
+	c.Check([]*big.Int{big.NewInt(1)}, EqualsWith([]*big.Int{big.NewInt(1)},
+		Comparer(func(a, b *big.Int) bool { return a.Cmp(b) == 0 })))
+
+	res, msg := EqualsWith([]string{"a", "c"}, SortSlices(byName)).Check([]interface{}{[]string{"b", "a"}}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "Difference:\n...     [1]: obtained \"b\", expected \"c\"")
+}
+
+func (s *EqualsSuite) TestEqualsWithWrongOptions(c *C) {
+	for _, test := range []struct {
+		opt EqualOption
+		msg string
+	}{
+		{IgnoreFields(1, "X"), "IgnoreFields expects a struct value, got int"},
+		{IgnoreFields(user{}, "X"), "IgnoreFields: checkers.user has no field X"},
+		{SortSlices(func(a string) bool { return true }), "SortSlices expects func(T, T) bool, got func(string) bool"},
+		{Comparer(1), "Comparer expects func(T, T) bool, got int"},
+	} {
+		res, msg := EqualsWith(1, test.opt).Check([]interface{}{1}, nil)
+		c.Check(res, IsFalse)
+		c.Check(msg, Equals, test.msg)
+	}
+}
+
+type address struct {
+	City, Street string
+	Zip          *int
+}
+
+type response struct {
+	Name    string
+	Age     int
+	Address *address
+	Items   []address
+	Labels  map[string]string
+	Extra   interface{}
+}
+
+func (s *EqualsSuite) TestPartiallyEquals(c *C) {
+	zip := 12345
+	resp := response{"x", 42, &address{"y", "z", &zip}, []address{{City: "a"}, {City: "b"}},
+		map[string]string{"env": "prod", "team": "core"}, 1.5}
+
+	c.Check(resp, PartiallyEquals, response{})
+	c.Check(resp, PartiallyEquals, response{Name: "x", Address: &address{City: "y"}})
+	c.Check(resp, PartiallyEquals, response{Items: []address{{}, {City: "b"}}})
+	c.Check(resp, PartiallyEquals, response{Labels: map[string]string{"env": "prod"}})
+	c.Check(resp, Not(PartiallyEquals), response{Items: []address{{City: "a"}}})
+
+	res, msg := PartiallyEquals.Check([]interface{}{resp, response{
+		Name:    "y",
+		Address: &address{City: "y", Street: "w"},
+		Labels:  map[string]string{"env": "dev", "owner": "me"},
+	}}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "Difference:\n"+
+		`...     .Name: obtained "x", expected "y"`+"\n"+
+		`...     .Address.Street: obtained "z", expected "w"`+"\n"+
+		`...     .Labels["env"]: obtained "prod", expected "dev"`+"\n"+
+		`...     .Labels["owner"]: missing key, expected "me"`)
+}
+
+// event is an API response with a timestamp.
+type event struct {
+	Name      string
+	CreatedAt time.Time
+}
+
+func (s *EqualsSuite) TestPartiallyEqualsTime(c *C) {
+	now := time.Now()
+	obtained := event{"x", now}
+
+	c.Check(obtained, PartiallyEquals, event{CreatedAt: now.Round(0)})
+	c.Check(obtained, PartiallyEquals, event{CreatedAt: now.UTC()})
+	c.Check(&obtained, PartiallyEquals, &event{Name: "x", CreatedAt: now.Round(0)})
+	c.Check(obtained, HasFields, map[string]interface{}{"CreatedAt": now.Round(0)})
+	c.Check(obtained, HasFields, map[string]interface{}{"CreatedAt": now.In(time.FixedZone("X", 3600))})
+
+	// same wall clock in another zone is another instant
+	utc := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	plus1 := time.Date(2020, 1, 2, 3, 4, 5, 0, time.FixedZone("CET", 3600))
+	c.Check(event{CreatedAt: plus1}, Not(PartiallyEquals), event{CreatedAt: utc})
+	c.Check(event{CreatedAt: plus1}, Not(HasFields), map[string]interface{}{"CreatedAt": utc})
+
+	res, msg := PartiallyEquals.Check([]interface{}{event{CreatedAt: plus1}, event{CreatedAt: utc}}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Matches, `Difference:\n\.\.\.     \.CreatedAt: obtained time\.Date\(2020, time\.January, 2, 3, 4, 5, 0, time\.Location\("CET"\)\), expected time\.Date\(2020, time\.January, 2, 3, 4, 5, 0, time\.UTC\)`)
+}
+
+func (s *EqualsSuite) TestHasFields(c *C) {
+	zip := 12345
+	resp := &response{"x", 42, &address{"y", "z", &zip}, []address{{City: "a"}, {City: "b"}},
+		map[string]string{"env": "prod"}, 1.5}
+
+	c.Check(resp, HasFields, map[string]interface{}{
+		"Name":           "x",
+		"Age":            int64(42),
+		"Address.City":   "y",
+		"Address.Zip":    &zip,
+		"Items[1].City":  "b",
+		"Items[0].Zip":   nil,
+		"Labels.env":     "prod",
+		`Labels["env"]`:  "prod",
+		"Extra":          1.5,
+		"Address.Street": "z",
+	})
+	c.Check(resp, Not(HasFields), map[string]interface{}{"Age": 42.5})
+
+	res, msg := HasFields.Check([]interface{}{resp, map[string]interface{}{
+		"Name":          "y",
+		"Address.Town":  "y",
+		"Items[2].City": "c",
+		"Labels.team":   "core",
+		"Age":           "42",
+	}}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "Difference:\n"+
+		"...     Address.Town: checkers.address has no field Town\n"+
+		"...     Age: obtained type int, expected type string\n"+
+		"...     Items[2].City: index [2] out of range for []checkers.address of length 2\n"+
+		`...     Labels.team: key "team" not found in map[string]string`+"\n"+
+		`...     Name: obtained "x", expected "y"`)
+
+	one := 1
+	type opt struct{ X, Y *int }
+	c.Check(opt{X: &one}, HasFields, map[string]interface{}{"X": 1, "Y": nil})
+	c.Check(opt{X: &one}, HasFields, map[string]interface{}{"X": int64(1)})
+	c.Check(opt{X: &one}, HasFields, map[string]interface{}{"X": &one})
+	c.Check(resp, HasFields, map[string]interface{}{"Address": address{"y", "z", &zip}})
+
+	res, msg = HasFields.Check([]interface{}{opt{X: &one}, map[string]interface{}{"X": 2, "Y": 1}}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "Difference:\n"+
+		"...     X: obtained 1, expected 2\n"+
+		"...     Y: obtained nil *int, expected 1")
+
+	res, msg = HasFields.Check([]interface{}{resp, map[string]string{}}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "expected value type must be map[string]interface{}")
+}
@@ -9,8 +9,17 @@ import (
 func Test(t *testing.T) {
 	Suite(&S{})
 	Suite(&Numeric{})
+	Suite(&OrderSuite{})
+	Suite(&PollSuite{})
+	Suite(&SequenceSuite{})
+	Suite(&SizeSuite{})
 	Suite(&Time{})
+	Suite(&TransformSuite{})
+	Suite(&CombineSuite{})
 	Suite(&ContainerSuite{})
+	Suite(&DiffSuite{})
+	Suite(&EqualsSuite{})
+	Suite(&ElementsSuite{})
 	Suite(&FileSuite{})
 	Suite(&SamePathLinuxSuite{})
 	Suite(&SamePathWindowsSuite{})
@@ -1,31 +1,95 @@
 package checkers
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
+	"math/big"
+	"reflect"
 
 	gc "gopkg.in/check.v1"
 )
 
+// toFloat converts any integer or floating point value, including values of
+// named types (eg time.Duration), json.Number and big numbers, to float64.
 func toFloat(val interface{}) (float64, string) {
 	switch t := val.(type) {
-	case float64:
-		return t, ""
-	case float32:
-		return float64(t), ""
-	case int:
-		return float64(t), ""
-	case int32:
-		return float64(t), ""
-	case int64:
-		return float64(t), ""
+	case json.Number:
+		f, err := t.Float64()
+		if err != nil {
+			return 0, fmt.Sprintf("Expecting a number, got: json.Number(%q)", t)
+		}
+		return f, ""
+	case *big.Int:
+		if t == nil {
+			return 0, "Expecting a number, got: (*big.Int)(nil)"
+		}
+		f, _ := new(big.Float).SetInt(t).Float64()
+		return f, ""
+	case *big.Float:
+		if t == nil {
+			return 0, "Expecting a number, got: (*big.Float)(nil)"
+		}
+		f, _ := t.Float64()
+		return f, ""
+	case *big.Rat:
+		if t == nil {
+			return 0, "Expecting a number, got: (*big.Rat)(nil)"
+		}
+		f, _ := t.Float64()
+		return f, ""
+	}
+
+	v := reflect.ValueOf(val)
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), ""
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), ""
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(v.Uint()), ""
 	}
 	return 0, fmt.Sprintf("Expecting a number, got: %T", val)
 }
 
+// toBigInt converts an integer value (any integer kind, *big.Int or an
+// integral json.Number) to *big.Int, without losing precision. It returns
+// false if val is not an integer.
+func toBigInt(val interface{}) (*big.Int, bool) {
+	switch t := val.(type) {
+	case json.Number:
+		return new(big.Int).SetString(string(t), 10)
+	case *big.Int:
+		return t, t != nil
+	}
+
+	v := reflect.ValueOf(val)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return big.NewInt(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Int).SetUint64(v.Uint()), true
+	}
+	return nil, false
+}
+
+// toBigInts converts all values with toBigInt. It returns false if any of
+// them is not an integer.
+func toBigInts(vals ...interface{}) ([]*big.Int, bool) {
+	ints := make([]*big.Int, len(vals))
+	for i, val := range vals {
+		var ok bool
+		if ints[i], ok = toBigInt(val); !ok {
+			return nil, false
+		}
+	}
+	return ints, true
+}
+
 // -----------------------------------------------------------------------
 func equalWithTolerance(a, b, tolerance float64) bool {
-	return math.Abs(a-b) <= tolerance
+	// a == b handles infinities, which would otherwise produce NaN
+	return a == b || math.Abs(a-b) <= tolerance
 }
 
 func withinBound(value, lower, upper float64) bool {
@@ -37,6 +101,11 @@ type equalsWithToleranceChecker struct {
 }
 
 func (c *equalsWithToleranceChecker) Check(params []interface{}, names []string) (result bool, error string) {
+	if ints, ok := toBigInts(params[0], params[1], params[2]); ok {
+		diff := new(big.Int).Sub(ints[0], ints[1])
+		return diff.Abs(diff).Cmp(ints[2]) <= 0, ""
+	}
+
 	var obtained, expected, tolerance float64
 	var errStr string
 	if obtained, errStr = toFloat(params[0]); errStr != "" {
@@ -51,7 +120,9 @@ func (c *equalsWithToleranceChecker) Check(params []interface{}, names []string)
 	return equalWithTolerance(obtained, expected, tolerance), ""
 }
 
-// EqualsWithTolerance Check if two numbers are close enough
+// EqualsWithTolerance Check if two numbers are close enough.
+// Integer operands are compared exactly; float semantics are used only when
+// any of the operands is a floating point number.
 var EqualsWithTolerance gc.Checker = &equalsWithToleranceChecker{&gc.CheckerInfo{Name: "EqualsWithTolerance", Params: []string{"obtained", "expected", "tolerance"}}}
 
 // CloseTo is an alias for EqualsWithTolerance
@@ -62,10 +133,16 @@ type betweenChecker struct {
 	*gc.CheckerInfo
 }
 
-// Between checks if a numeric values is between two other values
+// Between checks if a numeric values is between two other values.
+// Integer operands are compared exactly; float semantics are used only when
+// any of the operands is a floating point number.
 var Between gc.Checker = &betweenChecker{&gc.CheckerInfo{Name: "Between", Params: []string{"obtained", "lower", "upper"}}}
 
 func (c *betweenChecker) Check(params []interface{}, names []string) (result bool, error string) {
+	if ints, ok := toBigInts(params[0], params[1], params[2]); ok {
+		return ints[0].Cmp(ints[1]) >= 0 && ints[0].Cmp(ints[2]) <= 0, ""
+	}
+
 	var obtained, upper, lower float64
 	var errStr string
 	if obtained, errStr = toFloat(params[0]); errStr != "" {
@@ -79,3 +156,157 @@ func (c *betweenChecker) Check(params []interface{}, names []string) (result boo
 	}
 	return withinBound(obtained, lower, upper), ""
 }
+
+// -----------------------------------------------------------------------
+
+// FloatTolerance defines when two floating point numbers are considered equal.
+// Two finite numbers are equal if they are identical or if they satisfy any of
+// the non-zero tolerances:
+//
+//	|a - b| <= Abs
+//	|a - b| <= Rel * max(|a|, |b|)
+//	a and b are at most ULPs representable float64 values apart
+//
+// An infinity is equal only to the infinity of the same sign. NaN is never
+// equal to anything, unless EqualNaN is set, in which case NaN equals NaN.
+type FloatTolerance struct {
+	Abs      float64
+	Rel      float64
+	ULPs     uint64
+	EqualNaN bool
+}
+
+func (t FloatTolerance) equal(a, b float64) bool {
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return t.EqualNaN && math.IsNaN(a) && math.IsNaN(b)
+	}
+	if a == b {
+		return true
+	}
+	if math.IsInf(a, 0) || math.IsInf(b, 0) {
+		return false
+	}
+	diff := math.Abs(a - b)
+	return diff <= t.Abs ||
+		diff <= t.Rel*math.Max(math.Abs(a), math.Abs(b)) ||
+		ulpDistance(a, b) <= t.ULPs
+}
+
+// describeError reports the absolute, relative and ULP error between the
+// obtained and the expected values.
+func describeError(obtained, expected float64) string {
+	diff := math.Abs(obtained - expected)
+	rel := diff / math.Max(math.Abs(obtained), math.Abs(expected))
+	return fmt.Sprintf("absolute error %g, relative error %g, ULP distance %d",
+		diff, rel, ulpDistance(obtained, expected))
+}
+
+// ulpDistance returns the number of representable float64 values between a
+// and b.
+func ulpDistance(a, b float64) uint64 {
+	ia, ib := orderedBits(a), orderedBits(b)
+	if ia < ib {
+		ia, ib = ib, ia
+	}
+	// the unsigned subtraction can't overflow, even if the signed one does
+	return uint64(ia) - uint64(ib)
+}
+
+// orderedBits maps a float64 to an int64, so that the order of the integers
+// follows the order of the floats and -0 maps to the same value as +0.
+func orderedBits(f float64) int64 {
+	b := int64(math.Float64bits(f))
+	if b < 0 {
+		b = math.MinInt64 - b
+	}
+	return b
+}
+
+type floatToleranceChecker struct {
+	*gc.CheckerInfo
+	// tolerance builds the tolerance from the checker parameters following
+	// the obtained and the expected values.
+	tolerance func(params []interface{}) (FloatTolerance, string)
+}
+
+func (c *floatToleranceChecker) Check(params []interface{}, names []string) (result bool, error string) {
+	var obtained, expected float64
+	var errStr string
+	if obtained, errStr = toFloat(params[0]); errStr != "" {
+		return false, "Wrong obtained value: " + errStr
+	}
+	if expected, errStr = toFloat(params[1]); errStr != "" {
+		return false, "Wrong expected value: " + errStr
+	}
+	tolerance, errStr := c.tolerance(params[2:])
+	if errStr != "" {
+		return false, errStr
+	}
+	if tolerance.equal(obtained, expected) {
+		return true, ""
+	}
+	return false, describeError(obtained, expected)
+}
+
+func toTolerance(name string, val interface{}) (float64, string) {
+	f, errStr := toFloat(val)
+	if errStr != "" {
+		return 0, "Wrong " + name + " value: " + errStr
+	}
+	if f < 0 || math.IsNaN(f) {
+		return 0, fmt.Sprintf("Wrong %s value: must be a non-negative number, got %v", name, val)
+	}
+	return f, ""
+}
+
+// CloseWithin returns a checker which compares two numbers using the given
+// tolerance. Use it to opt in for NaN equality or to combine tolerances.
+// For example:
+//
+//	c.Assert(obtained, CloseWithin(FloatTolerance{Rel: 1e-9, EqualNaN: true}), expected)
+func CloseWithin(tolerance FloatTolerance) gc.Checker {
+	return &floatToleranceChecker{
+		&gc.CheckerInfo{Name: "CloseWithin", Params: []string{"obtained", "expected"}},
+		func([]interface{}) (FloatTolerance, string) { return tolerance, "" },
+	}
+}
+
+// RelativelyCloseTo checks if two numbers differ by at most
+// rel_tolerance * max(|obtained|, |expected|). See FloatTolerance for the
+// handling of NaN and infinities.
+var RelativelyCloseTo gc.Checker = &floatToleranceChecker{
+	&gc.CheckerInfo{Name: "RelativelyCloseTo", Params: []string{"obtained", "expected", "rel_tolerance"}},
+	func(params []interface{}) (t FloatTolerance, errStr string) {
+		t.Rel, errStr = toTolerance("rel_tolerance", params[0])
+		return
+	},
+}
+
+// IsClose combines a relative and an absolute tolerance, like Python's
+// math.isclose: two numbers are close if they satisfy any of the tolerances.
+// The absolute tolerance is useful for comparisons near zero. See
+// FloatTolerance for the handling of NaN and infinities.
+var IsClose gc.Checker = &floatToleranceChecker{
+	&gc.CheckerInfo{Name: "IsClose", Params: []string{"obtained", "expected", "rel_tolerance", "abs_tolerance"}},
+	func(params []interface{}) (t FloatTolerance, errStr string) {
+		if t.Rel, errStr = toTolerance("rel_tolerance", params[0]); errStr != "" {
+			return
+		}
+		t.Abs, errStr = toTolerance("abs_tolerance", params[1])
+		return
+	},
+}
+
+// WithinULPs checks if two numbers are at most `ulps` representable float64
+// values apart. See FloatTolerance for the handling of NaN and infinities.
+var WithinULPs gc.Checker = &floatToleranceChecker{
+	&gc.CheckerInfo{Name: "WithinULPs", Params: []string{"obtained", "expected", "ulps"}},
+	func(params []interface{}) (t FloatTolerance, errStr string) {
+		ulps, ok := toBigInt(params[0])
+		if !ok || !ulps.IsUint64() {
+			return t, fmt.Sprintf("Wrong ulps value: must be a non-negative integer, got %v", params[0])
+		}
+		t.ULPs = ulps.Uint64()
+		return t, ""
+	},
+}
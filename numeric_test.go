@@ -1,6 +1,11 @@
 package checkers
 
 import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"time"
+
 	. "gopkg.in/check.v1"
 )
 
@@ -15,3 +20,99 @@ func (s *Numeric) TestBounds(c *C) {
 	c.Check(1.0, Between, 0.0, 1.5)
 	c.Check(1.0, Not(Between), 2.0, 2.5)
 }
+
+type celsius float64
+
+func (s *Numeric) TestNumericTypes(c *C) {
+	c.Check(uint8(3), Between, 1, 5)
+	c.Check(int8(-3), Between, int16(-5), uint64(5))
+	c.Check(uintptr(3), Between, float32(2.5), 5)
+	c.Check(celsius(21.5), Between, celsius(18), celsius(24))
+	c.Check(1500*time.Millisecond, Between, time.Second, 2*time.Second)
+	c.Check(json.Number("1.5"), EqualsWithTolerance, 1, 0.5)
+	c.Check(big.NewInt(42), CloseTo, 42, 0)
+	c.Check(big.NewFloat(4.2), CloseTo, big.NewRat(21, 5), 1e-9)
+	c.Check(big.NewRat(1, 3), Not(Between), 0.5, 1)
+}
+
+func (s *Numeric) TestNotANumber(c *C) {
+	var nilInt *big.Int
+	for _, test := range []struct {
+		value interface{}
+		msg   string
+	}{
+		{"1", "Wrong obtained value: Expecting a number, got: string"},
+		{true, "Wrong obtained value: Expecting a number, got: bool"},
+		{nil, "Wrong obtained value: Expecting a number, got: <nil>"},
+		{json.Number("x"), `Wrong obtained value: Expecting a number, got: json.Number("x")`},
+		{nilInt, "Wrong obtained value: Expecting a number, got: (*big.Int)(nil)"},
+	} {
+		res, msg := Between.Check([]interface{}{test.value, 0, 1}, nil)
+		c.Check(res, IsFalse)
+		c.Check(msg, Equals, test.msg)
+	}
+}
+
+func (s *Numeric) TestIntegerPrecision(c *C) {
+	c.Check(int64(math.MaxInt64), Not(EqualsWithTolerance), int64(math.MaxInt64-1), 0)
+	c.Check(int64(math.MaxInt64), EqualsWithTolerance, int64(math.MaxInt64-1), 1)
+	c.Check(uint64(math.MaxUint64), Not(CloseTo), int64(math.MinInt64), uint64(math.MaxUint64))
+	c.Check(uint64(math.MaxUint64), Not(CloseTo), uint64(math.MaxUint64-1), 0)
+	c.Check(json.Number("9007199254740993"), Not(CloseTo), int64(1<<53), 0)
+	c.Check(new(big.Int).Lsh(big.NewInt(1), 100), CloseTo, new(big.Int).Lsh(big.NewInt(1), 100), 0)
+
+	c.Check(int64(math.MaxInt64), Not(Between), 0, int64(math.MaxInt64-1))
+	c.Check(uint64(1<<63+1), Not(Between), uint64(1<<63+2), uint64(math.MaxUint64))
+	c.Check(uint64(1<<63+1), Between, int64(math.MinInt64), uint64(1<<63+1))
+
+	// a float operand falls back to float semantics
+	c.Check(int64(1<<53+1), CloseTo, int64(1<<53), 0.0)
+}
+
+func (s *Numeric) TestRelativelyCloseTo(c *C) {
+	c.Check(1e9+1, RelativelyCloseTo, 1e9, 1e-6)
+	c.Check(1e-12, RelativelyCloseTo, 1.000001e-12, 1e-5)
+	c.Check(1e-12, Not(RelativelyCloseTo), 2e-12, 1e-5)
+	c.Check(0.0, Not(RelativelyCloseTo), 1e-300, 0.5)
+
+	res, msg := RelativelyCloseTo.Check([]interface{}{110.0, 100.0, 0.05}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Matches, `absolute error 10, relative error 0.0909090909\d*, ULP distance \d+`)
+
+	res, msg = RelativelyCloseTo.Check([]interface{}{1.0, 1.0, -1}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "Wrong rel_tolerance value: must be a non-negative number, got -1")
+}
+
+func (s *Numeric) TestIsClose(c *C) {
+	c.Check(1e-12, IsClose, 0.0, 1e-9, 1e-10)
+	c.Check(1e-12, Not(IsClose), 0.0, 1e-9, 0)
+	c.Check(1e9+1, IsClose, 1e9, 1e-6, 0)
+}
+
+func (s *Numeric) TestWithinULPs(c *C) {
+	c.Check(1.0, WithinULPs, math.Nextafter(1, 2), 1)
+	c.Check(1.0, Not(WithinULPs), math.Nextafter(math.Nextafter(1, 2), 2), 1)
+	c.Check(math.Copysign(0, -1), WithinULPs, 0.0, 0)
+	c.Check(-math.SmallestNonzeroFloat64, WithinULPs, math.SmallestNonzeroFloat64, 2)
+	c.Check(-math.MaxFloat64, WithinULPs, math.MaxFloat64, uint64(2*0x7fefffffffffffff))
+	c.Check(-math.MaxFloat64, Not(WithinULPs), math.MaxFloat64, uint64(2*0x7fefffffffffffff-1))
+
+	res, msg := WithinULPs.Check([]interface{}{1.0, 1.0, 0.5}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "Wrong ulps value: must be a non-negative integer, got 0.5")
+}
+
+func (s *Numeric) TestSpecialFloats(c *C) {
+	inf, nan := math.Inf(1), math.NaN()
+	c.Check(inf, CloseTo, inf, 0)
+	c.Check(inf, Not(CloseTo), -inf, 1)
+	c.Check(inf, RelativelyCloseTo, inf, 0)
+	c.Check(inf, Not(RelativelyCloseTo), math.MaxFloat64, 1)
+	c.Check(inf, Not(WithinULPs), math.MaxFloat64, 10)
+	c.Check(nan, Not(CloseTo), nan, 1)
+	c.Check(nan, Not(IsClose), nan, 1, 1)
+	c.Check(nan, CloseWithin(FloatTolerance{EqualNaN: true}), nan)
+	c.Check(nan, Not(CloseWithin(FloatTolerance{EqualNaN: true})), 1.0)
+	c.Check(1.0, CloseWithin(FloatTolerance{Abs: 0.1, Rel: 1e-9, ULPs: 4}), 1.05)
+}
@@ -0,0 +1,177 @@
+package checkers
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+
+	gc "gopkg.in/check.v1"
+)
+
+// compare returns -1, 0 or +1 depending on whether a is less than, equal to
+// or greater than b. It supports times (see asTime), strings (lexicographic order),
+// types with a `Compare(T) int` or `Less(T) bool` method and numbers of any
+// kind, including time.Duration, json.Number and the math/big types. Integers
+// are compared exactly.
+func compare(a, b interface{}) (int, string) {
+	if isTime(a) || isTime(b) {
+		if !isTimeLike(a) || !isTimeLike(b) {
+			return 0, fmt.Sprintf("can't compare %T with %T", a, b)
+		}
+		ta, errStr := asTime(a, "obtained")
+		if errStr != "" {
+			return 0, errStr
+		}
+		tb, errStr := asTime(b, "expected")
+		if errStr != "" {
+			return 0, errStr
+		}
+		switch {
+		case ta.Before(tb):
+			return -1, ""
+		case ta.After(tb):
+			return 1, ""
+		}
+		return 0, ""
+	}
+
+	if r, ok := compareByMethod(a, b); ok {
+		return r, ""
+	}
+
+	// json.Number is a string, but it's compared as a number
+	_, na := a.(json.Number)
+	_, nb := b.(json.Number)
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if !na && !nb && (va.Kind() == reflect.String || vb.Kind() == reflect.String) {
+		if va.Kind() != vb.Kind() {
+			return 0, fmt.Sprintf("can't compare %T with %T", a, b)
+		}
+		return strings.Compare(va.String(), vb.String()), ""
+	}
+
+	if ints, ok := toBigInts(a, b); ok {
+		return ints[0].Cmp(ints[1]), ""
+	}
+	fa, errStr := toFloat(a)
+	if errStr != "" {
+		return 0, fmt.Sprintf("can't compare %T with %T", a, b)
+	}
+	fb, errStr := toFloat(b)
+	if errStr != "" {
+		return 0, fmt.Sprintf("can't compare %T with %T", a, b)
+	}
+	if math.IsNaN(fa) || math.IsNaN(fb) {
+		return 0, "can't compare NaN"
+	}
+	switch {
+	case fa < fb:
+		return -1, ""
+	case fa > fb:
+		return 1, ""
+	}
+	return 0, ""
+}
+
+// compareByMethod compares a with b using the `Compare(T) int` or the
+// `Less(T) bool` method of a.
+func compareByMethod(a, b interface{}) (int, bool) {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if !va.IsValid() || !vb.IsValid() {
+		return 0, false
+	}
+	if m := va.MethodByName("Compare"); isMethod(m, vb.Type(), reflect.Int) {
+		r := m.Call([]reflect.Value{vb})[0].Int()
+		switch {
+		case r < 0:
+			return -1, true
+		case r > 0:
+			return 1, true
+		}
+		return 0, true
+	}
+	if m := va.MethodByName("Less"); isMethod(m, vb.Type(), reflect.Bool) {
+		if m.Call([]reflect.Value{vb})[0].Bool() {
+			return -1, true
+		}
+		if vb.Type() == va.Type() && vb.MethodByName("Less").Call([]reflect.Value{va})[0].Bool() {
+			return 1, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// isTimeLike checks if v can be compared with a time.
+func isTimeLike(v interface{}) bool {
+	if _, ok := v.(string); ok || isTime(v) {
+		return true
+	}
+	return isTextTime(reflect.ValueOf(v))
+}
+
+// isMethod checks if m is a valid method taking a single argument of type arg
+// and returning a single value of the given kind.
+func isMethod(m reflect.Value, arg reflect.Type, out reflect.Kind) bool {
+	if !m.IsValid() {
+		return false
+	}
+	mt := m.Type()
+	return mt.NumIn() == 1 && arg.AssignableTo(mt.In(0)) &&
+		mt.NumOut() == 1 && mt.Out(0).Kind() == out
+}
+
+// -----------------------------------------------------------------------
+type orderChecker struct {
+	*gc.CheckerInfo
+	relation string
+	accept   func(cmp int) bool
+}
+
+func (c *orderChecker) Check(params []interface{}, names []string) (result bool, error string) {
+	cmp, errStr := compare(params[0], params[1])
+	if errStr != "" {
+		return false, errStr
+	}
+	if c.accept(cmp) {
+		return true, ""
+	}
+	return false, fmt.Sprintf("%v is not %s %v", params[0], c.relation, params[1])
+}
+
+// GreaterThan checks if the obtained value is greater than the expected one.
+// It supports numbers of any kind, strings, time.Time, time.Duration and types
+// with a `Compare(T) int` or `Less(T) bool` method. A time can be compared
+// with any value accepted by WithinDuration, eg an RFC 3339 string.
+// For example:
+//
+//	c.Assert(len(items), GreaterThan, 5)
+var GreaterThan gc.Checker = &orderChecker{
+	&gc.CheckerInfo{Name: "GreaterThan", Params: []string{"obtained", "expected"}},
+	"greater than", func(cmp int) bool { return cmp > 0 }}
+
+// GreaterOrEqual checks if the obtained value is greater than or equal to the
+// expected one. See GreaterThan for the supported types.
+var GreaterOrEqual gc.Checker = &orderChecker{
+	&gc.CheckerInfo{Name: "GreaterOrEqual", Params: []string{"obtained", "expected"}},
+	"greater than or equal to", func(cmp int) bool { return cmp >= 0 }}
+
+// LessThan checks if the obtained value is less than the expected one.
+// See GreaterThan for the supported types.
+var LessThan gc.Checker = &orderChecker{
+	&gc.CheckerInfo{Name: "LessThan", Params: []string{"obtained", "expected"}},
+	"less than", func(cmp int) bool { return cmp < 0 }}
+
+// LessOrEqual checks if the obtained value is less than or equal to the
+// expected one. See GreaterThan for the supported types.
+var LessOrEqual gc.Checker = &orderChecker{
+	&gc.CheckerInfo{Name: "LessOrEqual", Params: []string{"obtained", "expected"}},
+	"less than or equal to", func(cmp int) bool { return cmp <= 0 }}
+
+// AtLeast is an alias for GreaterOrEqual
+var AtLeast = GreaterOrEqual
+
+// AtMost is an alias for LessOrEqual
+var AtMost = LessOrEqual
@@ -0,0 +1,89 @@
+package checkers
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+type OrderSuite struct{}
+
+type version struct{ major, minor int }
+
+func (v version) Less(o version) bool {
+	return v.major < o.major || v.major == o.major && v.minor < o.minor
+}
+
+type priority int
+
+func (p priority) Compare(o priority) int { return int(o) - int(p) } // reversed order
+
+func (s *OrderSuite) TestNumbers(c *C) {
+	c.Check(6, GreaterThan, 5)
+	c.Check(5, Not(GreaterThan), 5)
+	c.Check(5, GreaterOrEqual, 5)
+	c.Check(uint8(4), LessThan, 4.5)
+	c.Check(4.5, LessOrEqual, int64(5))
+	c.Check(int64(math.MaxInt64), GreaterThan, int64(math.MaxInt64-1))
+	c.Check(uint64(math.MaxUint64), GreaterThan, int64(-1))
+	c.Check(3, AtLeast, 3)
+	c.Check(3, AtMost, 3)
+
+	res, msg := GreaterThan.Check([]interface{}{1, 2}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "1 is not greater than 2")
+
+	res, msg = LessThan.Check([]interface{}{math.NaN(), 2}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "can't compare NaN")
+}
+
+func (s *OrderSuite) TestNumberTypes(c *C) {
+	c.Check(json.Number("10"), GreaterThan, json.Number("9"))
+	c.Check(json.Number("10"), GreaterThan, 9)
+	c.Check(9.5, LessThan, json.Number("10"))
+	c.Check(json.Number("1.5"), LessThan, json.Number("10"))
+	c.Check([]json.Number{"9", "10", "10.5"}, IsSorted)
+	c.Check(big.NewInt(10), GreaterThan, big.NewInt(9))
+	c.Check(new(big.Int).Lsh(big.NewInt(1), 100), GreaterThan, uint64(math.MaxUint64))
+	c.Check(big.NewFloat(2.5), Between, json.Number("2"), 3)
+	c.Check(big.NewRat(1, 3), LessThan, 0.34)
+
+	res, msg := GreaterThan.Check([]interface{}{json.Number("10"), "9"}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "can't compare json.Number with string")
+}
+
+func (s *OrderSuite) TestStrings(c *C) {
+	c.Check("b", GreaterThan, "a")
+	c.Check("abc", LessThan, "abd")
+	c.Check("abc", LessOrEqual, "abc")
+
+	res, msg := LessThan.Check([]interface{}{"a", 1}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "can't compare string with int")
+}
+
+func (s *OrderSuite) TestTimes(c *C) {
+	t := time.Now()
+	c.Check(t.Add(time.Second), GreaterThan, t)
+	c.Check(t, LessOrEqual, t)
+	c.Check(time.Second, LessThan, time.Minute)
+	c.Check(time.Minute, GreaterOrEqual, 60*time.Second)
+
+	res, msg := LessThan.Check([]interface{}{t, time.Second}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "can't compare time.Time with time.Duration")
+}
+
+func (s *OrderSuite) TestMethods(c *C) {
+	c.Check(version{1, 2}, GreaterThan, version{1, 1})
+	c.Check(version{1, 2}, LessThan, version{2, 0})
+	c.Check(version{1, 2}, GreaterOrEqual, version{1, 2})
+	c.Check(version{1, 2}, Not(LessThan), version{1, 2})
+	c.Check(priority(1), GreaterThan, priority(2))
+	c.Check(priority(1), LessOrEqual, priority(1))
+}
@@ -0,0 +1,198 @@
+package checkers
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// lookupPath resolves a path to a value nested in v. The path is a list of
+// struct field names or string map keys separated with dots, each optionally
+// followed by one or more `[index]` selectors for slices, arrays, strings and
+// maps. Pointers and interfaces are dereferenced on the way.
+// For example:
+//
+//	Address.City
+//	Items[2].Price
+//	Labels[env]
+func lookupPath(v reflect.Value, path string) (reflect.Value, string) {
+	rest := path
+	for rest != "" {
+		var sel string
+		switch {
+		case rest[0] == '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return v, fmt.Sprintf("unterminated [ in path %q", path)
+			}
+			sel, rest = rest[1:end], rest[end+1:]
+			var errStr string
+			if v, errStr = index(v, sel); errStr != "" {
+				return v, errStr
+			}
+			continue
+		case rest[0] == '.':
+			rest = rest[1:]
+		}
+		end := strings.IndexAny(rest, ".[")
+		if end < 0 {
+			end = len(rest)
+		}
+		sel, rest = rest[:end], rest[end:]
+		if sel == "" {
+			return v, fmt.Sprintf("empty field name in path %q", path)
+		}
+		var errStr string
+		if v, errStr = field(v, sel); errStr != "" {
+			return v, errStr
+		}
+	}
+	return v, ""
+}
+
+// indirect dereferences pointers and interfaces.
+func indirect(v reflect.Value) (reflect.Value, string) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return v, fmt.Sprintf("nil %s", v.Type())
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return v, "nil value"
+	}
+	return v, ""
+}
+
+// field selects a struct field or a map key.
+func field(v reflect.Value, name string) (reflect.Value, string) {
+	v, errStr := indirect(v)
+	if errStr != "" {
+		return v, fmt.Sprintf("can't select %s: %s", name, errStr)
+	}
+	switch v.Kind() {
+	case reflect.Struct:
+		f := v.FieldByName(name)
+		if !f.IsValid() {
+			return f, fmt.Sprintf("%s has no field %s", v.Type(), name)
+		}
+		return f, ""
+	case reflect.Map:
+		return index(v, name)
+	}
+	return v, fmt.Sprintf("can't select %s from %s", name, v.Type())
+}
+
+// index selects an element of a slice, array or string, or a map key.
+func index(v reflect.Value, sel string) (reflect.Value, string) {
+	v, errStr := indirect(v)
+	if errStr != "" {
+		return v, fmt.Sprintf("can't index [%s]: %s", sel, errStr)
+	}
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array, reflect.String:
+		i, err := strconv.Atoi(sel)
+		if err != nil {
+			return v, fmt.Sprintf("invalid index [%s] for %s", sel, v.Type())
+		}
+		if i < 0 || i >= v.Len() {
+			return v, fmt.Sprintf("index [%d] out of range for %s of length %d", i, v.Type(), v.Len())
+		}
+		return v.Index(i), ""
+	case reflect.Map:
+		key, errStr := parseKey(sel, v.Type().Key())
+		if errStr != "" {
+			return v, errStr
+		}
+		e := v.MapIndex(key)
+		if !e.IsValid() {
+			return e, fmt.Sprintf("key %s not found in %s", formatValue(key), v.Type())
+		}
+		return e, ""
+	}
+	return v, fmt.Sprintf("can't index [%s] %s", sel, v.Type())
+}
+
+// parseKey converts the text of a map selector to a key of type t.
+func parseKey(sel string, t reflect.Type) (reflect.Value, string) {
+	key := reflect.New(t).Elem()
+	var err error
+	switch t.Kind() {
+	case reflect.String:
+		if unquoted, uerr := strconv.Unquote(sel); uerr == nil {
+			sel = unquoted
+		}
+		key.SetString(sel)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var i int64
+		if i, err = strconv.ParseInt(sel, 10, t.Bits()); err == nil {
+			key.SetInt(i)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		var u uint64
+		if u, err = strconv.ParseUint(sel, 10, t.Bits()); err == nil {
+			key.SetUint(u)
+		}
+	default:
+		return key, fmt.Sprintf("unsupported map key type %s", t)
+	}
+	if err != nil {
+		return key, fmt.Sprintf("invalid key [%s] for key type %s", sel, t)
+	}
+	return key, ""
+}
+
+// convertTo converts v to type t if both are numbers or both are strings and
+// the conversion doesn't lose information.
+func convertTo(v reflect.Value, t reflect.Type) (reflect.Value, bool) {
+	if v.Type() == t {
+		return v, true
+	}
+	vc, tc := kindClass(v.Kind()), kindClass(t.Kind())
+	if vc == "" || tc == "" || (vc == "string") != (tc == "string") || !v.Type().ConvertibleTo(t) {
+		return v, false
+	}
+	c := v.Convert(t)
+	if vc != "string" && !sameNumber(v, c) {
+		return v, false
+	}
+	return c, true
+}
+
+func kindClass(k reflect.Kind) string {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return "int"
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return "uint"
+	case reflect.Float32, reflect.Float64:
+		return "float"
+	case reflect.String:
+		return "string"
+	}
+	return ""
+}
+
+// sameNumber compares two numbers of any integer or float kind exactly.
+func sameNumber(a, b reflect.Value) bool {
+	fa, fb := bigFloat(a), bigFloat(b)
+	return fa != nil && fb != nil && fa.Cmp(fb) == 0
+}
+
+func bigFloat(v reflect.Value) *big.Float {
+	switch kindClass(v.Kind()) {
+	case "int":
+		return new(big.Float).SetInt64(v.Int())
+	case "uint":
+		return new(big.Float).SetUint64(v.Uint())
+	case "float":
+		if math.IsNaN(v.Float()) {
+			return nil
+		}
+		return big.NewFloat(v.Float())
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package checkers
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	gc "gopkg.in/check.v1"
+)
+
+// poller runs a checker repeatedly on the values returned by the obtained
+// function.
+type poller struct {
+	kind     string
+	duration time.Duration
+	interval time.Duration
+	sub      *bound
+}
+
+func (c *poller) Info() *gc.CheckerInfo {
+	return &gc.CheckerInfo{
+		Name:   fmt.Sprintf("%s(%v, %v, %s)", c.kind, c.duration, c.interval, c.sub.name),
+		Params: []string{"obtained"},
+	}
+}
+
+func (c *poller) Check(params []interface{}, names []string) (result bool, error string) {
+	if c.interval <= 0 {
+		return false, fmt.Sprintf("%s: interval must be positive, got %v", c.kind, c.interval)
+	}
+	f := reflect.ValueOf(params[0])
+	if f.Kind() != reflect.Func || f.IsNil() || f.Type().NumIn() != 0 || f.Type().NumOut() != 1 {
+		return false, fmt.Sprintf("obtained value must be a func() T, got %T", params[0])
+	}
+
+	start := time.Now()
+	deadline := start.Add(c.duration)
+	for attempts := 1; ; attempts++ {
+		obtained := f.Call(nil)[0].Interface()
+		ok, errStr := checkOne(c.sub, obtained)
+		now := time.Now()
+		switch {
+		case c.kind == "Eventually" && ok:
+			return true, ""
+		case c.kind == "Eventually" && !now.Before(deadline):
+			return false, pollFailure(fmt.Sprintf("condition not met within %v", c.duration),
+				attempts, obtained, errStr)
+		case c.kind == "Consistently" && !ok:
+			return false, pollFailure(fmt.Sprintf("condition failed after %v", now.Sub(start)),
+				attempts, obtained, errStr)
+		case c.kind == "Consistently" && !now.Before(deadline):
+			return true, ""
+		}
+		wait := c.interval
+		if left := deadline.Sub(now); left < wait {
+			wait = left
+		}
+		time.Sleep(wait)
+	}
+}
+
+// pollFailure explains the failure of a polling checker.
+func pollFailure(reason string, attempts int, obtained interface{}, errStr string) string {
+	if errStr == "" {
+		errStr = "none"
+	}
+	return fmt.Sprintf("%s (%d attempts):\n...     last obtained: %#v\n...     last error: %s",
+		reason, attempts, obtained, indent(errStr))
+}
+
+// Eventually calls the obtained `func() T` every interval until the value it
+// returns passes the checker with the given arguments or the timeout expires.
+// On failure it reports the last obtained value, the last checker error and
+// the number of attempts. It panics if the number of the arguments doesn't
+// match the checker.
+// For example:
+//
+//	c.Assert(func() int { return len(queue.Items()) }, Eventually(time.Second, 10*time.Millisecond, Equals, 0))
+func Eventually(timeout, interval time.Duration, checker gc.Checker, args ...interface{}) gc.Checker {
+	return &poller{"Eventually", timeout, interval, Bind(checker, args...).(*bound)}
+}
+
+// Consistently calls the obtained `func() T` every interval for the given
+// duration and checks if all the returned values pass the checker with the
+// given arguments. It fails on the first value which doesn't pass.
+// See Eventually.
+func Consistently(duration, interval time.Duration, checker gc.Checker, args ...interface{}) gc.Checker {
+	return &poller{"Consistently", duration, interval, Bind(checker, args...).(*bound)}
+}
@@ -0,0 +1,63 @@
+package checkers
+
+import (
+	"sync/atomic"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+type PollSuite struct{}
+
+// counter returns a function returning the number of its calls.
+func counter() func() int32 {
+	var n int32
+	return func() int32 { return atomic.AddInt32(&n, 1) }
+}
+
+func (s *PollSuite) TestEventually(c *C) {
+	c.Check(counter(), Eventually(time.Second, time.Millisecond, Equals, int32(3)))
+	c.Check(func() []int { return []int{1, 2} }, Eventually(time.Second, time.Millisecond, LenAtLeast, 2))
+	c.Check(counter(), Not(Eventually(5*time.Millisecond, time.Millisecond, Equals, int32(-1))))
+
+	res, msg := Eventually(5*time.Millisecond, time.Millisecond, IsTrue).Check([]interface{}{func() bool { return false }}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Matches, `condition not met within 5ms \(\d+ attempts\):\n`+
+		`\.\.\.     last obtained: false\n`+
+		`\.\.\.     last error: none`)
+
+	next := counter()
+	res, msg = Eventually(5*time.Millisecond, time.Millisecond, LenAtMost, 1).Check([]interface{}{func() []int32 { return []int32{next(), 0} }}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Matches, `(?s)condition not met within 5ms \((\d+) attempts\):\n`+
+		`\.\.\.     last obtained: \[\]int32\{\d+, 0\}\n`+
+		`\.\.\.     last error: length 2 is not at most 1: .*`)
+}
+
+func (s *PollSuite) TestEventuallyErrors(c *C) {
+	c.Check(func() { Eventually(time.Second, time.Millisecond, Equals) }, PanicMatches,
+		"Wrong number of parameters for Equals: want 1, got 0")
+	c.Check(func() { Not(Consistently(time.Second, time.Millisecond, Between, 4)) }, PanicMatches,
+		"Wrong number of parameters for Between: want 2, got 1")
+
+	res, msg := Eventually(time.Second, time.Millisecond, IsTrue).Check([]interface{}{true}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "obtained value must be a func() T, got bool")
+
+	res, msg = Eventually(time.Second, 0, IsTrue).Check([]interface{}{counter()}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "Eventually: interval must be positive, got 0s")
+
+	c.Check(Eventually(time.Second, time.Millisecond, Equals, 1).Info().Name, Equals, "Eventually(1s, 1ms, Equals(1))")
+}
+
+func (s *PollSuite) TestConsistently(c *C) {
+	c.Check(func() int { return 1 }, Consistently(5*time.Millisecond, time.Millisecond, Equals, 1))
+	c.Check(counter(), Not(Consistently(time.Second, time.Millisecond, LessThan, int32(3))))
+
+	res, msg := Consistently(time.Second, time.Millisecond, LessThan, int32(3)).Check([]interface{}{counter()}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Matches, `condition failed after .+ \(3 attempts\):\n`+
+		`\.\.\.     last obtained: 3\n`+
+		`\.\.\.     last error: 3 is not less than 3`)
+}
@@ -0,0 +1,123 @@
+package checkers
+
+import (
+	"fmt"
+	"reflect"
+
+	gc "gopkg.in/check.v1"
+)
+
+type sequenceChecker struct {
+	name     string
+	expected []interface{}
+	// match checks the obtained sequence, it returns an explanation of the
+	// failure.
+	match func(obtained reflect.Value, expected []interface{}) (bool, string)
+}
+
+func (c *sequenceChecker) Info() *gc.CheckerInfo {
+	return &gc.CheckerInfo{Name: callName(c.name, c.expected), Params: []string{"obtained"}}
+}
+
+func (c *sequenceChecker) Check(params []interface{}, names []string) (result bool, error string) {
+	obtained := reflect.ValueOf(params[0])
+	if !isSeq(obtained) {
+		return false, fmt.Sprintf("obtained value must be a slice or an array, got %T", params[0])
+	}
+	return c.match(obtained, c.expected)
+}
+
+// ContainsInOrder checks if the obtained slice or array contains the expected
+// elements in the given relative order, not necessarily next to each other.
+// Elements are compared like in Contains.
+// For example:
+//
+//	c.Assert(events, ContainsInOrder("start", "commit", "stop"))
+func ContainsInOrder(expected ...interface{}) gc.Checker {
+	return &sequenceChecker{"ContainsInOrder", expected, matchInOrder}
+}
+
+func matchInOrder(obtained reflect.Value, expected []interface{}) (bool, string) {
+	i, last := 0, -1
+	for k, e := range expected {
+		for i < obtained.Len() && !elemEquals(obtained.Index(i), e) {
+			i++
+		}
+		if i == obtained.Len() {
+			if k == 0 {
+				return false, fmt.Sprintf("expected element [0] %#v not found", e)
+			}
+			return false, fmt.Sprintf("matched %d of %d elements, the last one at index %d; expected element [%d] %#v not found after it",
+				k, len(expected), last, k, e)
+		}
+		last = i
+		i++
+	}
+	return true, ""
+}
+
+// ContainsSequence checks if the obtained slice or array contains the expected
+// elements as a contiguous run. Elements are compared like in Contains.
+func ContainsSequence(expected ...interface{}) gc.Checker {
+	return &sequenceChecker{"ContainsSequence", expected, matchSequence}
+}
+
+func matchSequence(obtained reflect.Value, expected []interface{}) (bool, string) {
+	if len(expected) == 0 {
+		return true, ""
+	}
+	bestStart, bestLen := -1, 0
+	for start := 0; start < obtained.Len(); start++ {
+		n := 0
+		for n < len(expected) && start+n < obtained.Len() && elemEquals(obtained.Index(start+n), expected[n]) {
+			n++
+		}
+		if n == len(expected) {
+			return true, ""
+		}
+		if n > bestLen {
+			bestStart, bestLen = start, n
+		}
+	}
+	if bestLen == 0 {
+		return false, fmt.Sprintf("expected element [0] %#v not found", expected[0])
+	}
+	pos := bestStart + bestLen
+	got := "the end of the obtained value"
+	if pos < obtained.Len() {
+		got = fmt.Sprintf("%s at index %d", formatValue(obtained.Index(pos)), pos)
+	}
+	return false, fmt.Sprintf("longest match: %d of %d elements at index %d; expected element [%d] %#v, got %s",
+		bestLen, len(expected), bestStart, bestLen, expected[bestLen], got)
+}
+
+// StartsWith checks if the obtained slice or array starts with the expected
+// elements. Elements are compared like in Contains.
+func StartsWith(expected ...interface{}) gc.Checker {
+	return &sequenceChecker{"StartsWith", expected, func(obtained reflect.Value, expected []interface{}) (bool, string) {
+		return matchAt(obtained, expected, 0)
+	}}
+}
+
+// EndsWith checks if the obtained slice or array ends with the expected
+// elements. Elements are compared like in Contains.
+func EndsWith(expected ...interface{}) gc.Checker {
+	return &sequenceChecker{"EndsWith", expected, func(obtained reflect.Value, expected []interface{}) (bool, string) {
+		return matchAt(obtained, expected, obtained.Len()-len(expected))
+	}}
+}
+
+// matchAt checks if the expected elements are found at the given offset.
+func matchAt(obtained reflect.Value, expected []interface{}, offset int) (bool, string) {
+	if len(expected) > obtained.Len() {
+		return false, fmt.Sprintf("obtained value has %d elements, expected at least %d",
+			obtained.Len(), len(expected))
+	}
+	for k, e := range expected {
+		if !elemEquals(obtained.Index(offset+k), e) {
+			return false, fmt.Sprintf("matched %d of %d elements; at index %d expected %#v, got %s",
+				k, len(expected), offset+k, e, formatValue(obtained.Index(offset+k)))
+		}
+	}
+	return true, ""
+}
@@ -0,0 +1,65 @@
+package checkers
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+type SequenceSuite struct{}
+
+var events = []string{"start", "read", "write", "read", "commit", "stop"}
+
+func (s *SequenceSuite) TestContainsInOrder(c *C) {
+	c.Check(events, ContainsInOrder("start", "commit", "stop"))
+	c.Check(events, ContainsInOrder("read", "read"))
+	c.Check(events, ContainsInOrder())
+	c.Check([2]x{{"a"}, {"b"}}, ContainsInOrder(x{"a"}, x{"b"}))
+	c.Check(events, Not(ContainsInOrder("commit", "write")))
+	c.Check(ContainsInOrder("a", 1).Info().Name, Equals, `ContainsInOrder("a", 1)`)
+
+	res, msg := ContainsInOrder("start", "write", "commit", "read").Check([]interface{}{events}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, `matched 3 of 4 elements, the last one at index 4; expected element [3] "read" not found after it`)
+
+	res, msg = ContainsInOrder("a", "b").Check([]interface{}{[]string{"a", "x", "a"}}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, `matched 1 of 2 elements, the last one at index 0; expected element [1] "b" not found after it`)
+
+	res, msg = ContainsInOrder("open").Check([]interface{}{events}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, `expected element [0] "open" not found`)
+
+	res, msg = ContainsInOrder("open").Check([]interface{}{"open"}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "obtained value must be a slice or an array, got string")
+}
+
+func (s *SequenceSuite) TestContainsSequence(c *C) {
+	c.Check(events, ContainsSequence("read", "write", "read"))
+	c.Check(events, ContainsSequence("stop"))
+	c.Check(events, ContainsSequence())
+	c.Check(events, Not(ContainsSequence("start", "write")))
+
+	res, msg := ContainsSequence("read", "commit", "start").Check([]interface{}{events}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, `longest match: 2 of 3 elements at index 3; expected element [2] "start", got "stop" at index 5`)
+
+	res, msg = ContainsSequence("commit", "stop", "start").Check([]interface{}{events}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, `longest match: 2 of 3 elements at index 4; expected element [2] "start", got the end of the obtained value`)
+}
+
+func (s *SequenceSuite) TestStartsEndsWith(c *C) {
+	c.Check(events, StartsWith("start", "read"))
+	c.Check(events, EndsWith("commit", "stop"))
+	c.Check(events, StartsWith())
+	c.Check(events, EndsWith())
+	c.Check([]int{1, 2}, Not(StartsWith(2)))
+
+	res, msg := StartsWith("start", "write").Check([]interface{}{events}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, `matched 1 of 2 elements; at index 1 expected "write", got "read"`)
+
+	res, msg = EndsWith(1, 2, 3).Check([]interface{}{[]int{2, 3}}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "obtained value has 2 elements, expected at least 3")
+}
@@ -0,0 +1,183 @@
+package checkers
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+
+	gc "gopkg.in/check.v1"
+)
+
+// maxPreviewElems and maxPreviewBytes limit the preview of a collection shown
+// in the size checkers errors.
+const (
+	maxPreviewElems = 10
+	maxPreviewBytes = 64
+)
+
+var lenMethodType = reflect.TypeOf(func() int { return 0 })
+
+// lengthOf returns the length of a slice, an array, a pointer to an array,
+// a map, a string, a channel or a value with a `Len() int` method, such as
+// sort.Interface, *list.List or *bytes.Buffer.
+func lengthOf(obtained interface{}) (int, string) {
+	v := reflect.ValueOf(obtained)
+	if !v.IsValid() {
+		return 0, "obtained value has no length: nil"
+	}
+	switch v.Kind() {
+	case reflect.Array, reflect.Chan, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len(), ""
+	case reflect.Ptr:
+		if v.Type().Elem().Kind() == reflect.Array {
+			return v.Type().Elem().Len(), ""
+		}
+	}
+	if m := v.MethodByName("Len"); m.IsValid() && m.Type() == lenMethodType {
+		if v.Kind() == reflect.Ptr && v.IsNil() {
+			return 0, fmt.Sprintf("obtained value has no length: nil %s", v.Type())
+		}
+		return int(m.Call(nil)[0].Int()), ""
+	}
+	return 0, fmt.Sprintf("obtained value has no length: %T", obtained)
+}
+
+// capOf returns the capacity of a slice, an array, a pointer to an array or
+// a channel.
+func capOf(obtained interface{}) (int, string) {
+	v := reflect.ValueOf(obtained)
+	if v.IsValid() {
+		switch v.Kind() {
+		case reflect.Array, reflect.Chan, reflect.Slice:
+			return v.Cap(), ""
+		case reflect.Ptr:
+			if v.Type().Elem().Kind() == reflect.Array {
+				return v.Type().Elem().Len(), ""
+			}
+		}
+	}
+	return 0, fmt.Sprintf("obtained value has no capacity: %T", obtained)
+}
+
+// preview formats a collection for an error message, showing at most
+// maxPreviewElems elements or maxPreviewBytes bytes of a string.
+func preview(obtained interface{}) string {
+	v := reflect.ValueOf(obtained)
+	if !v.IsValid() {
+		return "nil"
+	}
+	switch v.Kind() {
+	case reflect.Array, reflect.Slice:
+		elems := make([]string, 0, maxPreviewElems+1)
+		for i := 0; i < v.Len() && i < maxPreviewElems; i++ {
+			elems = append(elems, formatValue(v.Index(i)))
+		}
+		if v.Len() > maxPreviewElems {
+			elems = append(elems, "...")
+		}
+		return fmt.Sprintf("%s{%s}", v.Type(), strings.Join(elems, ", "))
+	case reflect.Map:
+		elems := make([]string, 0, maxPreviewElems+1)
+		for i, k := range sortedKeys(v) {
+			if i == maxPreviewElems {
+				elems = append(elems, "...")
+				break
+			}
+			elems = append(elems, formatValue(k)+": "+formatValue(v.MapIndex(k)))
+		}
+		return fmt.Sprintf("%s{%s}", v.Type(), strings.Join(elems, ", "))
+	case reflect.String:
+		return truncate(v.String())
+	}
+	if s, ok := obtained.(fmt.Stringer); ok && !(v.Kind() == reflect.Ptr && v.IsNil()) {
+		return fmt.Sprintf("%s(%s)", v.Type(), truncate(s.String()))
+	}
+	return v.Type().String()
+}
+
+// truncate quotes s, cutting it to maxPreviewBytes bytes on a rune boundary.
+func truncate(s string) string {
+	if len(s) <= maxPreviewBytes {
+		return fmt.Sprintf("%q", s)
+	}
+	n := maxPreviewBytes
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return fmt.Sprintf("%q...", s[:n])
+}
+
+// toSize converts a size bound to a non-negative int.
+func toSize(val interface{}, name string) (int, string) {
+	i, ok := toBigInt(val)
+	if !ok || !i.IsInt64() || int64(int(i.Int64())) != i.Int64() {
+		return 0, fmt.Sprintf("%s must be an integer, got %T", name, val)
+	}
+	if i.Sign() < 0 {
+		return 0, fmt.Sprintf("%s must not be negative, got %d", name, i)
+	}
+	return int(i.Int64()), ""
+}
+
+// -----------------------------------------------------------------------
+type sizeChecker struct {
+	*gc.CheckerInfo
+	what     string
+	size     func(obtained interface{}) (int, string)
+	relation string
+	accept   func(size int, bounds []int) bool
+}
+
+func (c *sizeChecker) Check(params []interface{}, names []string) (result bool, error string) {
+	size, errStr := c.size(params[0])
+	if errStr != "" {
+		return false, errStr
+	}
+	bounds := make([]int, len(params)-1)
+	strBounds := make([]string, len(bounds))
+	for i, p := range params[1:] {
+		if bounds[i], errStr = toSize(p, paramName(c.CheckerInfo, names, i+1)); errStr != "" {
+			return false, errStr
+		}
+		strBounds[i] = fmt.Sprint(bounds[i])
+	}
+	if c.accept(size, bounds) {
+		return true, ""
+	}
+	return false, fmt.Sprintf("%s %d is not %s %s: %s",
+		c.what, size, c.relation, strings.Join(strBounds, " and "), preview(params[0]))
+}
+
+// LenBetween checks if the length of the obtained value is between the given
+// bounds, inclusive. It supports slices, arrays, maps, strings, channels and
+// types with a `Len() int` method, such as sort.Interface, *list.List or
+// *bytes.Buffer.
+// For example:
+//
+//	c.Assert(page.Items, LenBetween, 1, 50)
+var LenBetween gc.Checker = &sizeChecker{
+	&gc.CheckerInfo{Name: "LenBetween", Params: []string{"obtained", "min", "max"}},
+	"length", lengthOf, "between",
+	func(size int, bounds []int) bool { return size >= bounds[0] && size <= bounds[1] }}
+
+// LenAtLeast checks if the length of the obtained value is at least n.
+// See LenBetween for the supported types.
+var LenAtLeast gc.Checker = &sizeChecker{
+	&gc.CheckerInfo{Name: "LenAtLeast", Params: []string{"obtained", "n"}},
+	"length", lengthOf, "at least",
+	func(size int, bounds []int) bool { return size >= bounds[0] }}
+
+// LenAtMost checks if the length of the obtained value is at most n.
+// See LenBetween for the supported types.
+var LenAtMost gc.Checker = &sizeChecker{
+	&gc.CheckerInfo{Name: "LenAtMost", Params: []string{"obtained", "n"}},
+	"length", lengthOf, "at most",
+	func(size int, bounds []int) bool { return size <= bounds[0] }}
+
+// CapAtLeast checks if the capacity of the obtained slice, array or channel
+// is at least n.
+var CapAtLeast gc.Checker = &sizeChecker{
+	&gc.CheckerInfo{Name: "CapAtLeast", Params: []string{"obtained", "n"}},
+	"capacity", capOf, "at least",
+	func(size int, bounds []int) bool { return size >= bounds[0] }}
@@ -0,0 +1,97 @@
+package checkers
+
+import (
+	"bytes"
+	"container/list"
+	"sort"
+	"strings"
+
+	. "gopkg.in/check.v1"
+)
+
+type SizeSuite struct{}
+
+func (s *SizeSuite) TestLenBetween(c *C) {
+	c.Check([]int{1, 2, 3}, LenBetween, 1, 3)
+	c.Check([2]int{}, LenBetween, 2, 2)
+	c.Check(&[2]int{}, LenBetween, 0, 2)
+	c.Check(map[string]int{"a": 1}, LenBetween, 1, uint8(5))
+	c.Check("héllo", LenBetween, 6, 6)
+	c.Check(sort.IntSlice{3, 1}, LenBetween, 2, 2)
+	c.Check([]int{}, Not(LenBetween), 1, 3)
+
+	l := list.New()
+	l.PushBack(1)
+	l.PushBack(2)
+	c.Check(l, LenBetween, 2, 2)
+
+	res, msg := LenBetween.Check([]interface{}{[]int{1, 2, 3, 4}, 1, 3}, LenBetween.Info().Params)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "length 4 is not between 1 and 3: []int{1, 2, 3, 4}")
+
+	res, msg = LenBetween.Check([]interface{}{[]int{1}, "a", 3}, LenBetween.Info().Params)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "min must be an integer, got string")
+
+	res, msg = LenBetween.Check([]interface{}{[]int{1}, -1, 3}, LenBetween.Info().Params)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "min must not be negative, got -1")
+
+	res, msg = LenAtMost.Check([]interface{}{[]int{}, int8(-2)}, LenAtMost.Info().Params)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "n must not be negative, got -2")
+
+	res, msg = LenBetween.Check([]interface{}{[]int{1}, 0, "3"}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "max must be an integer, got string")
+	c.Check([]int{1}, Bind(LenBetween, 1, 2))
+	c.Check([]int{1}, Not(Bind(CapAtLeast, 2)))
+
+	res, msg = LenBetween.Check([]interface{}{42, 1, 3}, LenBetween.Info().Params)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "obtained value has no length: int")
+
+	res, msg = LenBetween.Check([]interface{}{nil, 1, 3}, LenBetween.Info().Params)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "obtained value has no length: nil")
+}
+
+func (s *SizeSuite) TestLenAtLeastAtMost(c *C) {
+	ch := make(chan int, 3)
+	ch <- 1
+	c.Check(ch, LenAtLeast, 1)
+	c.Check(ch, LenAtMost, 1)
+	c.Check(bytes.NewBufferString("abc"), LenAtLeast, 3)
+	c.Check("abc", Not(LenAtMost), 2)
+
+	res, msg := LenAtMost.Check([]interface{}{make([]int, 12), 5}, LenAtMost.Info().Params)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "length 12 is not at most 5: []int{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, ...}")
+
+	res, msg = LenAtLeast.Check([]interface{}{map[string]int{"b": 2, "a": 1}, 3}, LenAtLeast.Info().Params)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, `length 2 is not at least 3: map[string]int{"a": 1, "b": 2}`)
+
+	long := strings.Repeat("x", 70)
+	res, msg = LenAtMost.Check([]interface{}{long, 5}, LenAtMost.Info().Params)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, `length 70 is not at most 5: "`+long[:64]+`"...`)
+
+	res, msg = LenAtMost.Check([]interface{}{bytes.NewBufferString("abc"), 2}, LenAtMost.Info().Params)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, `length 3 is not at most 2: *bytes.Buffer("abc")`)
+}
+
+func (s *SizeSuite) TestCapAtLeast(c *C) {
+	c.Check(make([]int, 0, 10), CapAtLeast, 10)
+	c.Check(make(chan int, 4), CapAtLeast, 4)
+	c.Check([3]int{}, CapAtLeast, 3)
+
+	res, msg := CapAtLeast.Check([]interface{}{make([]int, 1, 2), 8}, CapAtLeast.Info().Params)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "capacity 2 is not at least 8: []int{0}")
+
+	res, msg = CapAtLeast.Check([]interface{}{"abc", 1}, CapAtLeast.Info().Params)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "obtained value has no capacity: string")
+}
@@ -0,0 +1,128 @@
+package checkers
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	gc "gopkg.in/check.v1"
+)
+
+// Check runs any gc.Checker (including gc.Not(...) and third party checkers)
+// outside of the gocheck runner. If the check fails, the problem is reported
+// the same way gocheck does it, the test is marked as failed and the
+// execution continues. The last argument may be a gc.CommentInterface, such
+// as Comment or CommentSpew, which is logged next to the reported problem.
+// For example:
+//
+//	func TestFoo(t *testing.T) {
+//		checkers.Check(t, []int{3, 1}, checkers.SameContent, []int{1, 3})
+//	}
+func Check(t testing.TB, obtained interface{}, checker gc.Checker, args ...interface{}) bool {
+	t.Helper()
+	if msg, ok := runCheck("Check", obtained, checker, args); !ok {
+		t.Error(msg)
+		return false
+	}
+	return true
+}
+
+// Assert is like Check, but it stops the test execution if the check fails.
+func Assert(t testing.TB, obtained interface{}, checker gc.Checker, args ...interface{}) {
+	t.Helper()
+	if msg, ok := runCheck("Assert", obtained, checker, args); !ok {
+		t.Fatal(msg)
+	}
+}
+
+// runCheck mirrors gocheck's C.internalCheck. It returns the failure report
+// and false if the check doesn't pass.
+func runCheck(funcName string, obtained interface{}, checker gc.Checker, args []interface{}) (string, bool) {
+	if checker == nil {
+		return fmt.Sprintf("%s(obtained, nil!?, ...):\n... Oops.. you've provided a nil checker!", funcName), false
+	}
+
+	// If the last argument is a bug info, extract it out.
+	var comment gc.CommentInterface
+	if len(args) > 0 {
+		if c, ok := args[len(args)-1].(gc.CommentInterface); ok {
+			comment = c
+			args = args[:len(args)-1]
+		}
+	}
+
+	params := append([]interface{}{obtained}, args...)
+	info := checker.Info()
+	names := append([]string{info.Params[0], info.Name}, info.Params[1:]...)
+	header := fmt.Sprintf("%s(%s):\n", funcName, strings.Join(names, ", "))
+
+	if len(params) != len(info.Params) {
+		return header + fmt.Sprintf("... Wrong number of parameters for %s: want %d, got %d",
+			info.Name, len(names), len(params)+1), false
+	}
+
+	// Copy since it may be mutated by Check.
+	names = append([]string{}, info.Params...)
+
+	result, errStr := checker.Check(params, names)
+	if result && errStr == "" {
+		return "", true
+	}
+	var b bytes.Buffer
+	b.WriteString(header)
+	for i := range params {
+		writeValue(&b, names[i], params[i])
+	}
+	if comment != nil {
+		fmt.Fprintln(&b, "...", comment.CheckCommentString())
+	}
+	if errStr != "" {
+		fmt.Fprintln(&b, "...", errStr)
+	}
+	return strings.TrimSuffix(b.String(), "\n"), false
+}
+
+// writeValue formats a checker parameter like gocheck's C.logValue does.
+func writeValue(b *bytes.Buffer, label string, value interface{}) {
+	if value == nil {
+		fmt.Fprintf(b, "... %s = nil\n", label)
+		return
+	}
+	if hasStringOrError(value) {
+		fv := fmt.Sprintf("%#v", value)
+		qv := fmt.Sprintf("%q", value)
+		if fv != qv {
+			fmt.Fprintf(b, "... %s %s = %s (%s)\n", label, reflect.TypeOf(value), fv, qv)
+			return
+		}
+	}
+	if s, ok := value.(string); ok && strings.Contains(strings.TrimSuffix(s, "\n"), "\n") {
+		fmt.Fprintf(b, "... %s %s = \"\" +\n", label, reflect.TypeOf(value))
+		lines := strings.SplitAfter(s, "\n")
+		if lines[len(lines)-1] == "" {
+			lines = lines[:len(lines)-1]
+		}
+		for i, l := range lines {
+			b.WriteString("...     " + strconv.Quote(l))
+			if i < len(lines)-1 {
+				b.WriteString(" +")
+			}
+			b.WriteByte('\n')
+		}
+		return
+	}
+	fmt.Fprintf(b, "... %s %s = %#v\n", label, reflect.TypeOf(value), value)
+}
+
+// copied from gopkg.in/check.v1
+func hasStringOrError(x interface{}) (ok bool) {
+	_, ok = x.(fmt.Stringer)
+	if ok {
+		return
+	}
+	_, ok = x.(error)
+	return
+}
@@ -0,0 +1,86 @@
+package checkers
+
+import (
+	"strings"
+	"testing"
+
+	gc "gopkg.in/check.v1"
+)
+
+// fakeTB records the reported failures instead of failing the test.
+type fakeTB struct {
+	testing.TB
+	errors []string
+	fatal  bool
+}
+
+func (t *fakeTB) Helper() {}
+
+func (t *fakeTB) Error(args ...interface{}) {
+	t.errors = append(t.errors, args[0].(string))
+}
+
+func (t *fakeTB) Fatal(args ...interface{}) {
+	t.Error(args...)
+	t.fatal = true
+}
+
+func TestCheckPasses(t *testing.T) {
+	Check(t, true, IsTrue)
+	Check(t, []int{3, 1}, SameContent, []int{1, 3})
+	Check(t, 5, gc.Not(Between), 1, 3)
+	Assert(t, "foo bar", HasPrefix, "foo", Comment("not reported"))
+}
+
+func TestCheckReportsFailure(t *testing.T) {
+	ft := &fakeTB{}
+	if Check(ft, 1, Between, 2, 3, Comment("value ", 1)) {
+		t.Fatal("Check should fail")
+	}
+	if ft.fatal {
+		t.Error("Check should not stop the test")
+	}
+	expected := "Check(obtained, Between, lower, upper):\n" +
+		"... obtained int = 1\n" +
+		"... lower int = 2\n" +
+		"... upper int = 3\n" +
+		"... value 1"
+	if len(ft.errors) != 1 || ft.errors[0] != expected {
+		t.Errorf("unexpected report: %q", ft.errors)
+	}
+}
+
+func TestAssertReportsFailure(t *testing.T) {
+	ft := &fakeTB{}
+	Assert(ft, "a\nb\n", gc.Equals, "a\n")
+	if !ft.fatal {
+		t.Error("Assert should stop the test")
+	}
+	expected := "Assert(obtained, Equals, expected):\n" +
+		"... obtained string = \"\" +\n" +
+		"...     \"a\\n\" +\n" +
+		"...     \"b\\n\"\n" +
+		"... expected string = \"a\\n\""
+	if len(ft.errors) != 1 || ft.errors[0] != expected {
+		t.Errorf("unexpected report: %q", ft.errors)
+	}
+}
+
+func TestCheckReportsCheckerError(t *testing.T) {
+	ft := &fakeTB{}
+	Check(ft, []int{1, 2}, SliceEquals, []int{1, 3})
+	if len(ft.errors) != 1 || !strings.HasSuffix(ft.errors[0], "\n... Difference:\n...     [1]: obtained 2, expected 3") {
+		t.Errorf("unexpected report: %q", ft.errors)
+	}
+}
+
+func TestCheckWrongParameters(t *testing.T) {
+	ft := &fakeTB{}
+	Check(ft, 1, Between, 2)
+	Check(ft, 1, nil)
+	if len(ft.errors) != 2 ||
+		ft.errors[0] != "Check(obtained, Between, lower, upper):\n... Wrong number of parameters for Between: want 4, got 3" ||
+		ft.errors[1] != "Check(obtained, nil!?, ...):\n... Oops.. you've provided a nil checker!" {
+		t.Errorf("unexpected report: %q", ft.errors)
+	}
+}
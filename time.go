@@ -1,22 +1,27 @@
 package checkers
 
 import (
+	"encoding"
 	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
 	"time"
 
 	gc "gopkg.in/check.v1"
 )
 
-// TimeBetween returns a time between checker
-func TimeBetween(start, end time.Time) gc.Checker {
-	if end.Before(start) {
-		return &timeBetweenChecker{end, start}
-	}
+// TimeBetween returns a time between checker. The obtained value and the
+// bounds can be any time accepted by WithinDuration, including times relative
+// to the DefaultClock created with FromNow.
+func TimeBetween(start, end interface{}) gc.Checker {
 	return &timeBetweenChecker{start, end}
 }
 
 type timeBetweenChecker struct {
-	start, end time.Time
+	start, end interface{}
 }
 
 func (checker *timeBetweenChecker) Info() *gc.CheckerInfo {
@@ -28,39 +33,213 @@ func (checker *timeBetweenChecker) Info() *gc.CheckerInfo {
 }
 
 func (checker *timeBetweenChecker) Check(params []interface{}, names []string) (result bool, error string) {
-	when, ok := params[0].(time.Time)
-	if !ok {
-		return false, "obtained value type must be time.Time"
+	when, errstr := asTime(params[0], "obtained")
+	if errstr != "" {
+		return false, errstr
+	}
+	start, errstr := asTime(checker.start, "start")
+	if errstr != "" {
+		return false, errstr
+	}
+	end, errstr := asTime(checker.end, "end")
+	if errstr != "" {
+		return false, errstr
 	}
-	if when.Before(checker.start) {
-		return false, fmt.Sprintf("obtained value %#v type must before start value of %#v", when, checker.start)
+	if end.Before(start) {
+		start, end = end, start
+	}
+	if when.Before(start) {
+		return false, fmt.Sprintf("obtained value %#v type must before start value of %#v", when, start)
 	}
-	if when.After(checker.end) {
-		return false, fmt.Sprintf("obtained value %#v type must after end value of %#v", when, checker.end)
+	if when.After(end) {
+		return false, fmt.Sprintf("obtained value %#v type must after end value of %#v", when, end)
 	}
 	return true, ""
 }
 
+// toDuration converts a time.Duration, a duration string like "1m30s" (see
+// time.ParseDuration) or an integer number of nanoseconds to time.Duration.
+// name is the name of the value used in the error message.
+func toDuration(v interface{}, name string) (time.Duration, string) {
+	switch d := v.(type) {
+	case time.Duration:
+		return d, ""
+	case string:
+		parsed, err := time.ParseDuration(d)
+		if err != nil {
+			return 0, fmt.Sprintf("can't parse %s value: %v", name, err)
+		}
+		return parsed, ""
+	}
+	if i, ok := toBigInt(v); ok {
+		if !i.IsInt64() {
+			return 0, fmt.Sprintf("%s value %v out of time.Duration range", name, i)
+		}
+		return time.Duration(i.Int64()), ""
+	}
+	return 0, fmt.Sprintf("%s value type must be time.Duration, a duration string or integer nanoseconds, got %T", name, v)
+}
+
 // -----------------------------------------------------------------------
-type durationLessThanChecker struct {
+type durationChecker struct {
 	*gc.CheckerInfo
+	relation string
+	accept   func(obtained time.Duration, bounds []time.Duration) bool
+}
+
+func (checker *durationChecker) Check(params []interface{}, names []string) (result bool, error string) {
+	obtained, errstr := toDuration(params[0], "obtained")
+	if errstr != "" {
+		return false, errstr
+	}
+	bounds := make([]time.Duration, len(params)-1)
+	strBounds := make([]string, len(bounds))
+	for i, p := range params[1:] {
+		if bounds[i], errstr = toDuration(p, checker.Params[i+1]); errstr != "" {
+			return false, errstr
+		}
+		strBounds[i] = bounds[i].String()
+	}
+	if checker.accept(obtained, bounds) {
+		return true, ""
+	}
+	return false, fmt.Sprintf("%v is not %s %s", obtained, checker.relation, strings.Join(strBounds, " and "))
 }
 
-// DurationLessThan checker
-var DurationLessThan gc.Checker = &durationLessThanChecker{
+// DurationLessThan checks if the obtained duration is less than the expected
+// one. The durations can be time.Duration values, duration strings like
+// "1m30s" or integer numbers of nanoseconds.
+var DurationLessThan gc.Checker = &durationChecker{
 	&gc.CheckerInfo{Name: "DurationLessThan", Params: []string{"obtained", "expected"}},
+	"less than", func(d time.Duration, bounds []time.Duration) bool { return d < bounds[0] }}
+
+// DurationGreaterThan checks if the obtained duration is greater than the
+// expected one. See DurationLessThan for the supported values.
+var DurationGreaterThan gc.Checker = &durationChecker{
+	&gc.CheckerInfo{Name: "DurationGreaterThan", Params: []string{"obtained", "expected"}},
+	"greater than", func(d time.Duration, bounds []time.Duration) bool { return d > bounds[0] }}
+
+// DurationBetween checks if the obtained duration is between the lower and
+// the upper bound, inclusive. See DurationLessThan for the supported values.
+// For example:
+//
+//	c.Assert(backoff, DurationBetween, "100ms", "200ms")
+var DurationBetween gc.Checker = &durationChecker{
+	&gc.CheckerInfo{Name: "DurationBetween", Params: []string{"obtained", "lower", "upper"}},
+	"between", func(d time.Duration, bounds []time.Duration) bool { return d >= bounds[0] && d <= bounds[1] }}
+
+// -----------------------------------------------------------------------
+type durationCloseTo struct {
+	*gc.CheckerInfo
 }
 
-func (checker *durationLessThanChecker) Check(params []interface{}, names []string) (result bool, error string) {
-	obtained, ok := params[0].(time.Duration)
-	if !ok {
-		return false, "obtained value type must be time.Duration"
+func (checker *durationCloseTo) Check(params []interface{}, names []string) (result bool, error string) {
+	obtained, errstr := toDuration(params[0], "obtained")
+	if errstr != "" {
+		return false, errstr
 	}
-	expected, ok := params[1].(time.Duration)
-	if !ok {
-		return false, "expected value type must be time.Duration"
+	expected, errstr := toDuration(params[1], "expected")
+	if errstr != "" {
+		return false, errstr
+	}
+	var tolerance time.Duration
+	if pct, ok := params[2].(string); ok && strings.HasSuffix(pct, "%") {
+		p, err := strconv.ParseFloat(strings.TrimSuffix(pct, "%"), 64)
+		if err != nil || p < 0 {
+			return false, fmt.Sprintf("invalid tolerance percentage %q", pct)
+		}
+		tolerance = clampDuration(math.Abs(float64(expected)) * p / 100)
+	} else if tolerance, errstr = toDuration(params[2], "tolerance"); errstr != "" {
+		return false, errstr
+	}
+	if tolerance < 0 {
+		return false, fmt.Sprintf("tolerance must not be negative, got %v", tolerance)
+	}
+	if absDiff(obtained, expected) <= uint64(tolerance) {
+		return true, ""
+	}
+	return false, fmt.Sprintf("%v is not within %v of %v (difference %s)",
+		obtained, tolerance, expected, formatDiff(obtained, expected))
+}
+
+// absDiff returns |a - b| without overflow.
+func absDiff(a, b time.Duration) uint64 {
+	if a < b {
+		a, b = b, a
+	}
+	return uint64(a) - uint64(b)
+}
+
+// formatDiff formats a - b, which may be out of the time.Duration range.
+func formatDiff(a, b time.Duration) string {
+	d := absDiff(a, b)
+	if d > math.MaxInt64 {
+		diff := new(big.Int).Sub(big.NewInt(int64(a)), big.NewInt(int64(b)))
+		return diff.String() + "ns"
+	}
+	if a < b {
+		return (-time.Duration(d)).String()
 	}
-	return obtained.Nanoseconds() < expected.Nanoseconds(), ""
+	return time.Duration(d).String()
+}
+
+// clampDuration converts nanoseconds to time.Duration, clamping them to the
+// time.Duration range.
+func clampDuration(ns float64) time.Duration {
+	switch {
+	case ns >= math.MaxInt64:
+		return math.MaxInt64
+	case ns <= math.MinInt64:
+		return math.MinInt64
+	}
+	return time.Duration(ns)
+}
+
+// DurationCloseTo checks if the obtained duration differs from the expected
+// one by at most the tolerance. The tolerance is a duration or a percentage of
+// the expected duration, like "10%". See DurationLessThan for the supported
+// values.
+// For example:
+//
+//	c.Assert(elapsed, DurationCloseTo, time.Second, 50*time.Millisecond)
+//	c.Assert(elapsed, DurationCloseTo, "1s", "5%")
+var DurationCloseTo gc.Checker = &durationCloseTo{
+	&gc.CheckerInfo{Name: "DurationCloseTo", Params: []string{"obtained", "expected", "tolerance"}}}
+
+// -----------------------------------------------------------------------
+type completesWithin struct {
+	max time.Duration
+}
+
+func (checker *completesWithin) Info() *gc.CheckerInfo {
+	return &gc.CheckerInfo{
+		Name:   fmt.Sprintf("CompletesWithin(%v)", checker.max),
+		Params: []string{"obtained"},
+	}
+}
+
+func (checker *completesWithin) Check(params []interface{}, names []string) (result bool, error string) {
+	f, ok := params[0].(func())
+	if !ok || f == nil {
+		return false, fmt.Sprintf("obtained value must be a func(), got %T", params[0])
+	}
+	start := time.Now()
+	f()
+	elapsed := time.Since(start)
+	if elapsed <= checker.max {
+		return true, ""
+	}
+	return false, fmt.Sprintf("took %v, more than %v", elapsed, checker.max)
+}
+
+// CompletesWithin runs the obtained func() and checks if it returns within
+// the given duration. The function always runs to completion, so the failure
+// can report the measured elapsed time.
+// For example:
+//
+//	c.Assert(func() { cache.Warm() }, CompletesWithin(100*time.Millisecond))
+func CompletesWithin(max time.Duration) gc.Checker {
+	return &completesWithin{max}
 }
 
 // -----------------------------------------------------------------------
@@ -73,9 +252,9 @@ func (checker *withinDuration) Check(params []interface{}, names []string) (resu
 	if ok || errstr != "" {
 		return ok, errstr
 	}
-	maxDiff, ok := params[2].(time.Duration)
-	if !ok {
-		return false, "max_diff value type must be time.Duration"
+	maxDiff, errstr := toDuration(params[2], "max_diff")
+	if errstr != "" {
+		return false, errstr
 	}
 	dt := expected.Sub(obtained)
 	if dt >= -maxDiff && dt <= maxDiff {
@@ -84,13 +263,18 @@ func (checker *withinDuration) Check(params []interface{}, names []string) (resu
 	return false, ""
 }
 
-// WithinDuration checkes if time between obtained and expected is within duration
+// WithinDuration checkes if time between obtained and expected is within duration.
+// Besides time.Time and *time.Time, the times can be RFC 3339 strings, Unix
+// timestamps (see Unix), strings in other layouts (see ParseTime), timestamp
+// types implementing encoding.TextUnmarshaler and times relative to the
+// DefaultClock (see FromNow). The same applies to the other time checkers.
 var WithinDuration gc.Checker = &withinDuration{
 	&gc.CheckerInfo{Name: "WithinDuration", Params: []string{"obtained", "expected", "max_diff"}}}
 
 // -----------------------------------------------------------------------
 type timeEquals struct {
 	*gc.CheckerInfo
+	maxDiff time.Duration
 }
 
 func (checker *timeEquals) Check(params []interface{}, names []string) (result bool, errstr string) {
@@ -98,42 +282,357 @@ func (checker *timeEquals) Check(params []interface{}, names []string) (result b
 	if ok || errstr != "" {
 		return ok, errstr
 	}
-	maxDiff := time.Microsecond
 	dt := expected.Sub(obtained)
-	if dt >= -maxDiff && dt <= maxDiff {
+	if dt >= -checker.maxDiff && dt <= checker.maxDiff {
 		return true, ""
 	}
-	return false, ""
+	return false, timeDiff(fmt.Sprintf("times differ by more than %v", checker.maxDiff), obtained, expected)
 }
 
-// TimeEquals checks if time is the same up to microseconds
+// TimeEquals checks if time is the same up to microseconds.
+// Use TimeEqualsAt for other precisions.
 var TimeEquals gc.Checker = &timeEquals{
-	&gc.CheckerInfo{Name: "TimeEquals", Params: []string{"obtained", "expected"}}}
+	&gc.CheckerInfo{Name: "TimeEquals", Params: []string{"obtained", "expected"}}, time.Microsecond}
+
+// timeDiff explains why two times are not equal.
+func timeDiff(reason string, obtained, expected time.Time) string {
+	return fmt.Sprintf("%s:\n...     obtained: %s\n...     expected: %s\n...     delta: %v",
+		reason, obtained.Format(time.RFC3339Nano), expected.Format(time.RFC3339Nano), obtained.Sub(expected))
+}
+
+// -----------------------------------------------------------------------
+
+// TimeOption configures the comparison done by TimeEqualsAt.
+type TimeOption func(*timeOptions)
+
+type timeOptions struct {
+	round        bool
+	sameLocation bool
+	monotonic    bool
+}
+
+// Truncate makes TimeEqualsAt truncate both times to the precision before
+// comparing them. This is the default, and matches stores which drop the
+// extra digits, like Postgres.
+func Truncate() TimeOption {
+	return func(o *timeOptions) { o.round = false }
+}
+
+// Round makes TimeEqualsAt round both times to the nearest multiple of the
+// precision before comparing them, with halfway values rounded up.
+func Round() TimeOption {
+	return func(o *timeOptions) { o.round = true }
+}
+
+// SameLocation makes TimeEqualsAt also compare the locations of the times, so
+// the same instant in different time zones is not equal.
+func SameLocation() TimeOption {
+	return func(o *timeOptions) { o.sameLocation = true }
+}
+
+// CompareMonotonic makes TimeEqualsAt also compare the monotonic clock
+// readings of the times at the same precision. A time with a monotonic
+// clock reading is not equal to a time without one.
+func CompareMonotonic() TimeOption {
+	return func(o *timeOptions) { o.monotonic = true }
+}
+
+// monotonic returns the monotonic clock reading of t in nanoseconds. The
+// reading is available only through the "m=±<seconds>" suffix of t.String().
+func monotonic(t time.Time) (time.Duration, bool) {
+	s := t.String()
+	i := strings.LastIndex(s, " m=")
+	if i < 0 {
+		return 0, false
+	}
+	d, err := time.ParseDuration(s[i+3:] + "s")
+	return d, err == nil
+}
+
+type timeEqualsAt struct {
+	precision time.Duration
+	opts      timeOptions
+}
+
+// TimeEqualsAt checks if the obtained and the expected times are equal at the
+// given precision. By default both times are truncated to the precision
+// (see Truncate, Round), and the same instant in different locations is
+// equal (see SameLocation). On failure both times are shown in RFC3339Nano
+// with the delta between them.
+// For example:
+//
+//	c.Assert(row.UpdatedAt, TimeEqualsAt(time.Second), expected)          // MySQL DATETIME
+//	c.Assert(msg.SentAt, TimeEqualsAt(time.Millisecond, Round()), expected) // JavaScript clients
+func TimeEqualsAt(precision time.Duration, opts ...TimeOption) gc.Checker {
+	c := &timeEqualsAt{precision: precision}
+	for _, opt := range opts {
+		opt(&c.opts)
+	}
+	return c
+}
+
+func (checker *timeEqualsAt) Info() *gc.CheckerInfo {
+	return &gc.CheckerInfo{
+		Name:   fmt.Sprintf("TimeEqualsAt(%v)", checker.precision),
+		Params: []string{"obtained", "expected"},
+	}
+}
+
+// reduce truncates or rounds t to the precision.
+func (checker *timeEqualsAt) reduce(t time.Time) time.Time {
+	if checker.opts.round {
+		return t.Round(checker.precision)
+	}
+	return t.Truncate(checker.precision)
+}
+
+func (checker *timeEqualsAt) Check(params []interface{}, names []string) (result bool, errstr string) {
+	obtained, expected, ok, errstr := toTime(params[0], params[1])
+	if ok || errstr != "" {
+		return ok, errstr
+	}
+	if !checker.reduce(obtained).Equal(checker.reduce(expected)) {
+		return false, timeDiff(fmt.Sprintf("times differ at precision %v", checker.precision), obtained, expected)
+	}
+	if checker.opts.sameLocation && obtained.Location().String() != expected.Location().String() {
+		return false, timeDiff(fmt.Sprintf("locations differ: obtained %s, expected %s",
+			obtained.Location(), expected.Location()), obtained, expected)
+	}
+	if checker.opts.monotonic {
+		om, hasOm := monotonic(obtained)
+		em, hasEm := monotonic(expected)
+		if hasOm != hasEm {
+			return false, fmt.Sprintf("monotonic clock readings differ: obtained %s, expected %s",
+				monotonicString(om, hasOm), monotonicString(em, hasEm))
+		}
+		if hasOm && checker.reduceDuration(om) != checker.reduceDuration(em) {
+			return false, fmt.Sprintf("monotonic clock readings differ at precision %v: obtained %s, expected %s, delta %v",
+				checker.precision, monotonicString(om, true), monotonicString(em, true), om-em)
+		}
+	}
+	return true, ""
+}
+
+// reduceDuration truncates or rounds a monotonic clock reading to the precision.
+func (checker *timeEqualsAt) reduceDuration(d time.Duration) time.Duration {
+	if checker.opts.round {
+		return d.Round(checker.precision)
+	}
+	return d.Truncate(checker.precision)
+}
+
+func monotonicString(d time.Duration, ok bool) string {
+	if !ok {
+		return "none"
+	}
+	return fmt.Sprintf("m=%+.9f", d.Seconds())
+}
 
 // -----------------------------------------------------------------------
 
+// toTime converts the obtained and expected values to time.Time with asTime.
+// It returns true if both are nil *time.Time, which are equal.
 func toTime(a, b interface{}) (time.Time, time.Time, bool, string) {
-	obtained := time.Time{}
+	pa, aIsPtr := a.(*time.Time)
+	pb, bIsPtr := b.(*time.Time)
+	if aIsPtr && bIsPtr && pa == nil && pb == nil {
+		return time.Time{}, time.Time{}, true, ""
+	}
+	if (aIsPtr && pa == nil) || (bIsPtr && pb == nil) {
+		return time.Time{}, time.Time{}, false, "comparing nil value with non-nil"
+	}
+	obtained, errstr := asTime(a, "obtained")
+	if errstr != "" {
+		return obtained, obtained, false, errstr
+	}
+	expected, errstr := asTime(b, "expected")
+	return obtained, expected, false, errstr
+}
+
+// UnixTime is a Unix timestamp counted in the given unit, eg time.Second or
+// time.Millisecond. See Unix.
+type UnixTime struct {
+	Value int64
+	Unit  time.Duration
+}
+
+// Unix returns a Unix timestamp, which the time checkers accept in place of a
+// time.Time. Plain integers are not accepted, because their unit would be
+// ambiguous.
+// For example:
+//
+//	c.Assert(Unix(payload.CreatedMs, time.Millisecond), IsRecent(time.Minute))
+func Unix(value int64, unit time.Duration) UnixTime {
+	return UnixTime{value, unit}
+}
 
-	switch v := a.(type) {
+// Time returns the time of the timestamp.
+func (u UnixTime) Time() (time.Time, string) {
+	if u.Unit <= 0 {
+		return time.Time{}, fmt.Sprintf("Unix time unit must be positive, got %v", u.Unit)
+	}
+	ns := new(big.Int).Mul(big.NewInt(u.Value), big.NewInt(int64(u.Unit)))
+	sec, nsec := ns.DivMod(ns, big.NewInt(int64(time.Second)), new(big.Int))
+	if !sec.IsInt64() {
+		return time.Time{}, fmt.Sprintf("Unix time %d * %v out of range", u.Value, u.Unit)
+	}
+	return time.Unix(sec.Int64(), nsec.Int64()).UTC(), ""
+}
+
+// TimeString is a time formatted with the given layout. See ParseTime.
+type TimeString struct {
+	Layout string
+	Value  string
+}
+
+// ParseTime returns a time formatted with the given layout (see time.Parse),
+// which the time checkers accept in place of a time.Time. Plain strings are
+// accepted as well, in the RFC 3339 format.
+// For example:
+//
+//	c.Assert(ParseTime(time.RFC1123, resp.Header.Get("Date")), IsRecent(time.Minute))
+func ParseTime(layout, value string) TimeString {
+	return TimeString{layout, value}
+}
+
+var (
+	timeType            = reflect.TypeOf(time.Time{})
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// asTime converts a value to time.Time. It accepts:
+//
+//	time.Time and non-nil *time.Time
+//	RelativeTime, resolved with DefaultClock
+//	UnixTime and TimeString
+//	strings in the RFC 3339 format, with optional fractional seconds
+//	types implementing encoding.TextUnmarshaler, such as time.Time wrappers:
+//	they are converted to time.Time if possible, otherwise the text returned
+//	by their MarshalText method is parsed in the RFC 3339 format.
+//
+// name is the name of the value used in the error message.
+func asTime(v interface{}, name string) (time.Time, string) {
+	var err error
+	switch t := v.(type) {
 	case time.Time:
-		expected, ok := b.(time.Time)
-		if !ok {
-			return obtained, obtained, false, "expected value type must be time.Time"
-		}
-		return v, expected, false, ""
+		return t, ""
 	case *time.Time:
-		expected, ok := b.(*time.Time)
-		if !ok {
-			return obtained, obtained, false, "expected value type must be *time.Time"
+		if t != nil {
+			return *t, ""
+		}
+		return time.Time{}, fmt.Sprintf("%s value is nil *time.Time", name)
+	case RelativeTime:
+		return t.Time(), ""
+	case UnixTime:
+		tm, errstr := t.Time()
+		if errstr != "" {
+			return tm, fmt.Sprintf("can't convert %s value: %s", name, errstr)
+		}
+		return tm, ""
+	case TimeString:
+		var tm time.Time
+		if tm, err = time.Parse(t.Layout, t.Value); err == nil {
+			return tm, ""
+		}
+	case string:
+		var tm time.Time
+		if tm, err = time.Parse(time.RFC3339Nano, t); err == nil {
+			return tm, ""
+		}
+	default:
+		rv := reflect.ValueOf(v)
+		if !isTextTime(rv) {
+			break
 		}
-		if v == nil && expected == nil {
-			return obtained, obtained, true, ""
+		if rv.Type().ConvertibleTo(timeType) {
+			return rv.Convert(timeType).Interface().(time.Time), ""
 		}
-		if v != nil && expected != nil {
-			return *v, *expected, false, ""
+		var text []byte
+		if text, err = v.(encoding.TextMarshaler).MarshalText(); err == nil {
+			var tm time.Time
+			if err = tm.UnmarshalText(text); err == nil {
+				return tm, ""
+			}
 		}
-		return obtained, obtained, false, "comparing nil value with non-nil"
 	}
-	return obtained, obtained, false, "expected and obtained value type must be time.Time or *time.Time"
+	if err != nil {
+		return time.Time{}, fmt.Sprintf("can't parse %s value: %v", name, err)
+	}
+	return time.Time{}, fmt.Sprintf("%s value type must be a time, got %T", name, v)
+}
+
+// isTextTime checks if v is a value of a type implementing
+// encoding.TextUnmarshaler which can be converted to time.Time.
+func isTextTime(v reflect.Value) bool {
+	if !v.IsValid() || !(v.Type().Implements(textUnmarshalerType) ||
+		reflect.PtrTo(v.Type()).Implements(textUnmarshalerType)) {
+		return false
+	}
+	if v.Type().ConvertibleTo(timeType) {
+		return true
+	}
+	_, ok := v.Interface().(encoding.TextMarshaler)
+	return ok
+}
+
+// isTime checks if v is a value which asTime converts, other than a string.
+// Strings are ambiguous: they are compared as times only with other times.
+func isTime(v interface{}) bool {
+	switch v.(type) {
+	case time.Time, *time.Time, RelativeTime, UnixTime, TimeString:
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	return rv.IsValid() && rv.Type().ConvertibleTo(timeType)
+}
+
+// -----------------------------------------------------------------------
+type clockChecker struct {
+	*gc.CheckerInfo
+	// d is the max age of the accepted times, or the max time ahead of the
+	// current time of DefaultClock if future is set.
+	d      time.Duration
+	future bool
+}
+
+func (checker *clockChecker) Check(params []interface{}, names []string) (result bool, error string) {
+	if checker.d < 0 {
+		return false, fmt.Sprintf("%s: duration must not be negative", checker.Name)
+	}
+	when, errstr := asTime(params[0], "obtained")
+	if errstr != "" {
+		return false, errstr
+	}
+	now := DefaultClock.Now()
+	dt := when.Sub(now)
+	relation := fmt.Sprintf("within %v before now", checker.d)
+	ok := dt <= 0 && dt >= -checker.d
+	if checker.future {
+		relation = fmt.Sprintf("within %v after now", checker.d)
+		ok = dt > 0 && dt <= checker.d
+	}
+	if ok {
+		return true, ""
+	}
+	return false, fmt.Sprintf("%s is not %s (now is %s, difference %v)",
+		when.Format(time.RFC3339Nano), relation, now.Format(time.RFC3339Nano), dt)
+}
+
+// IsRecent checks if the obtained time is not in the future and at most
+// maxAge before the current time of DefaultClock.
+// For example:
+//
+//	c.Assert(user.CreatedAt, IsRecent(time.Second))
+func IsRecent(maxAge time.Duration) gc.Checker {
+	return &clockChecker{
+		&gc.CheckerInfo{Name: fmt.Sprintf("IsRecent(%v)", maxAge), Params: []string{"obtained"}},
+		maxAge, false}
+}
+
+// IsInFuture checks if the obtained time is after the current time of
+// DefaultClock by at most within.
+func IsInFuture(within time.Duration) gc.Checker {
+	return &clockChecker{
+		&gc.CheckerInfo{Name: fmt.Sprintf("IsInFuture(%v)", within), Params: []string{"obtained"}},
+		within, true}
 }
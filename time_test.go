@@ -1,6 +1,7 @@
 package checkers
 
 import (
+	"math"
 	"time"
 
 	. "gopkg.in/check.v1"
@@ -31,3 +32,306 @@ func (ts *Time) TestTimeEquals(c *C) {
 
 	c.Check(t1, Not(TimeEquals), t1.Add(time.Microsecond+1))
 }
+
+func (ts *Time) TestTimeBetween(c *C) {
+	t1 := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	c.Check(t1, TimeBetween(t1.Add(-time.Second), t1.Add(time.Second)))
+	c.Check(t1, TimeBetween(t1.Add(time.Second), t1.Add(-time.Second)))
+	c.Check(t1, TimeBetween(t1, t1))
+	c.Check(t1, Not(TimeBetween(t1.Add(time.Second), t1.Add(time.Minute))))
+
+	res, msg := TimeBetween(t1, 42).Check([]interface{}{t1}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "end value type must be a time, got int")
+}
+
+func (ts *Time) TestFakeClock(c *C) {
+	t1 := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	clock := NewFakeClock(t1)
+	c.Check(clock.Now(), Equals, t1)
+	clock.Advance(time.Minute)
+	c.Check(clock.Now(), Equals, t1.Add(time.Minute))
+	clock.Set(t1)
+	c.Check(clock.Now(), Equals, t1)
+
+	restore := UseClock(clock)
+	c.Check(DefaultClock, Equals, clock)
+	c.Check(FromNow(-time.Hour).Time(), Equals, t1.Add(-time.Hour))
+	c.Check(FromNow(-time.Hour).String(), Equals, "now-1h0m0s")
+	c.Check(FromNow(0).String(), Equals, "now")
+	restore()
+	c.Check(DefaultClock, Equals, SystemClock)
+}
+
+func (ts *Time) TestRelativeTime(c *C) {
+	t1 := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	defer UseClock(NewFakeClock(t1))()
+
+	c.Check(t1, WithinDuration, FromNow(time.Second), time.Second)
+	c.Check(FromNow(0), TimeEquals, t1)
+	c.Check(t1, TimeBetween(FromNow(-time.Second), FromNow(time.Second)))
+	c.Check(t1, Not(TimeBetween(FromNow(time.Second), FromNow(time.Hour))))
+	c.Check(t1, LessThan, FromNow(time.Second))
+	c.Check(FromNow(0), GreaterOrEqual, t1)
+}
+
+func (ts *Time) TestIsRecent(c *C) {
+	t1 := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	clock := NewFakeClock(t1)
+	defer UseClock(clock)()
+
+	c.Check(t1, IsRecent(time.Second))
+	c.Check(t1.Add(-time.Second), IsRecent(time.Second))
+	c.Check(&t1, IsRecent(0))
+	c.Check(t1.Add(time.Nanosecond), Not(IsRecent(time.Second)))
+
+	clock.Advance(time.Minute)
+	res, msg := IsRecent(time.Second).Check([]interface{}{t1}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "2020-01-02T03:04:05Z is not within 1s before now (now is 2020-01-02T03:05:05Z, difference -1m0s)")
+
+	res, msg = IsRecent(-time.Second).Check([]interface{}{t1}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "IsRecent(-1s): duration must not be negative")
+
+	res, msg = IsRecent(time.Second).Check([]interface{}{time.Second}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "obtained value type must be a time, got time.Duration")
+}
+
+func (ts *Time) TestIsInFuture(c *C) {
+	t1 := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	defer UseClock(NewFakeClock(t1))()
+
+	c.Check(t1.Add(time.Hour), IsInFuture(time.Hour))
+	c.Check(t1, Not(IsInFuture(time.Hour)))
+	c.Check(t1.Add(time.Hour+1), Not(IsInFuture(time.Hour)))
+
+	res, msg := IsInFuture(time.Minute).Check([]interface{}{t1.Add(-time.Second)}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "2020-01-02T03:04:04Z is not within 1m0s after now (now is 2020-01-02T03:04:05Z, difference -1s)")
+}
+
+func (ts *Time) TestTimeEqualsFailure(c *C) {
+	t1 := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	res, msg := TimeEquals.Check([]interface{}{t1, t1.Add(time.Millisecond)}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "times differ by more than 1µs:\n"+
+		"...     obtained: 2020-01-02T03:04:05Z\n"+
+		"...     expected: 2020-01-02T03:04:05.001Z\n"+
+		"...     delta: -1ms")
+}
+
+func (ts *Time) TestTimeEqualsAt(c *C) {
+	t1 := time.Date(2020, 1, 2, 3, 4, 5, 600000000, time.UTC)
+
+	c.Check(t1, TimeEqualsAt(time.Second), t1.Truncate(time.Second))
+	c.Check(t1, TimeEqualsAt(time.Second, Truncate()), t1.Add(300*time.Millisecond))
+	c.Check(t1, Not(TimeEqualsAt(time.Second)), t1.Add(500*time.Millisecond))
+	c.Check(t1, TimeEqualsAt(time.Second, Round()), t1.Add(500*time.Millisecond))
+	c.Check(t1, Not(TimeEqualsAt(time.Second, Round())), t1.Truncate(time.Second))
+	c.Check(t1, TimeEqualsAt(0), t1)
+	c.Check(t1, Not(TimeEqualsAt(0)), t1.Add(1))
+	c.Check(FromNow(0), TimeEqualsAt(time.Hour), FromNow(0))
+	c.Check((*time.Time)(nil), TimeEqualsAt(time.Second), (*time.Time)(nil))
+	c.Check(TimeEqualsAt(time.Millisecond).Info().Name, Equals, "TimeEqualsAt(1ms)")
+
+	res, msg := TimeEqualsAt(time.Millisecond).Check([]interface{}{t1, t1.Add(-time.Millisecond)}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "times differ at precision 1ms:\n"+
+		"...     obtained: 2020-01-02T03:04:05.6Z\n"+
+		"...     expected: 2020-01-02T03:04:05.599Z\n"+
+		"...     delta: 1ms")
+}
+
+func (ts *Time) TestTimeEqualsAtLocation(c *C) {
+	t1 := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	t2 := t1.In(time.FixedZone("CET", 3600))
+
+	c.Check(t1, TimeEqualsAt(time.Second), t2)
+	c.Check(t1, TimeEqualsAt(time.Second, SameLocation()), t1)
+	res, msg := TimeEqualsAt(time.Second, SameLocation()).Check([]interface{}{t1, t2}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "locations differ: obtained UTC, expected CET:\n"+
+		"...     obtained: 2020-01-02T03:04:05Z\n"+
+		"...     expected: 2020-01-02T04:04:05+01:00\n"+
+		"...     delta: 0s")
+}
+
+func (ts *Time) TestTimeEqualsAtMonotonic(c *C) {
+	t1 := time.Now()
+	c.Check(t1, TimeEqualsAt(time.Microsecond, CompareMonotonic()), t1)
+	c.Check(t1.Round(0), TimeEqualsAt(time.Microsecond, CompareMonotonic()), t1.Round(0))
+	c.Check(t1, TimeEqualsAt(time.Microsecond), t1.Round(0))
+
+	res, msg := TimeEqualsAt(time.Microsecond, CompareMonotonic()).Check([]interface{}{t1, t1.Round(0)}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Matches, `monotonic clock readings differ: obtained m=\+\d+\.\d{9}, expected none`)
+
+	m1, ok1 := monotonic(t1)
+	m2, ok2 := monotonic(t1.Add(time.Millisecond))
+	c.Check(ok1 && ok2, IsTrue)
+	c.Check(m2-m1, Equals, time.Millisecond)
+	_, ok1 = monotonic(t1.Round(0))
+	c.Check(ok1, IsFalse)
+}
+
+// timestamp is a time.Time wrapper, as found in API clients.
+type timestamp time.Time
+
+func (t *timestamp) UnmarshalText(text []byte) error {
+	return (*time.Time)(t).UnmarshalText(text)
+}
+
+// textTime is a timestamp which is not convertible to time.Time.
+type textTime struct{ s string }
+
+func (t textTime) MarshalText() ([]byte, error)     { return []byte(t.s), nil }
+func (t *textTime) UnmarshalText(text []byte) error { t.s = string(text); return nil }
+
+func (ts *Time) TestTimeConversions(c *C) {
+	t1 := time.Date(2020, 1, 2, 3, 4, 5, 123000000, time.UTC)
+
+	c.Check("2020-01-02T03:04:05.123Z", TimeEquals, t1)
+	c.Check("2020-01-02T04:04:05.123+01:00", TimeEquals, t1)
+	c.Check("2020-01-02T03:04:05Z", WithinDuration, t1, time.Second)
+	c.Check(ParseTime(time.RFC1123, "Thu, 02 Jan 2020 03:04:05 UTC"), TimeEqualsAt(time.Second), t1)
+	c.Check(Unix(1577934245, time.Second), TimeEqualsAt(time.Second), t1)
+	c.Check(Unix(1577934245123, time.Millisecond), TimeEquals, t1)
+	c.Check(Unix(1577934245123000000, time.Nanosecond), TimeEquals, t1)
+	c.Check(Unix(-1, time.Millisecond), TimeEquals, time.Unix(0, -1e6))
+	c.Check(timestamp(t1), TimeEquals, t1)
+	c.Check(textTime{"2020-01-02T03:04:05.123Z"}, TimeEquals, t1)
+	c.Check(t1, TimeBetween("2020-01-01T00:00:00Z", Unix(1577934246, time.Second)))
+	c.Check("2020-01-02T03:04:05.123Z", TimeBetween(t1, t1))
+
+	res, msg := TimeEquals.Check([]interface{}{"yesterday", t1}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, `can't parse obtained value: parsing time "yesterday" as "2006-01-02T15:04:05.999999999Z07:00": cannot parse "yesterday" as "2006"`)
+
+	res, msg = WithinDuration.Check([]interface{}{t1, ParseTime(time.Kitchen, "noon"), time.Hour}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, `can't parse expected value: parsing time "noon" as "3:04PM": cannot parse "noon" as "3"`)
+
+	res, msg = TimeEquals.Check([]interface{}{int64(1577934245), t1}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "obtained value type must be a time, got int64")
+
+	res, msg = TimeEquals.Check([]interface{}{Unix(1, 0), t1}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "can't convert obtained value: Unix time unit must be positive, got 0s")
+
+	res, msg = TimeEquals.Check([]interface{}{Unix(math.MaxInt64, time.Hour), t1}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "can't convert obtained value: Unix time 9223372036854775807 * 1h0m0s out of range")
+
+	res, msg = TimeEquals.Check([]interface{}{textTime{"soon"}, t1}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Matches, "can't parse obtained value: .*")
+}
+
+func (ts *Time) TestTimeOrdering(c *C) {
+	t1 := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	c.Check("2020-01-02T03:04:06Z", GreaterThan, t1)
+	c.Check(t1, LessThan, "2020-01-02T03:04:06Z")
+	c.Check(Unix(1577934245, time.Second), GreaterOrEqual, t1)
+	c.Check(timestamp(t1), LessOrEqual, t1)
+	c.Check("b", GreaterThan, "a")
+
+	res, msg := GreaterThan.Check([]interface{}{t1, "later"}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Matches, `can't parse expected value: parsing time "later".*`)
+
+	res, msg = GreaterThan.Check([]interface{}{t1, 42}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "can't compare time.Time with int")
+}
+
+func (ts *Time) TestDurationOrdering(c *C) {
+	c.Check(time.Second, DurationLessThan, time.Minute)
+	c.Check("1m30s", DurationLessThan, "2m")
+	c.Check(int64(1000), DurationLessThan, time.Microsecond+1)
+	c.Check(time.Second, Not(DurationLessThan), time.Second)
+	c.Check(time.Minute, DurationGreaterThan, "59s")
+	c.Check(time.Minute, Not(DurationGreaterThan), time.Minute)
+	c.Check(150*time.Millisecond, DurationBetween, "100ms", 200*time.Millisecond)
+	c.Check(time.Second, DurationBetween, time.Second, time.Second)
+
+	res, msg := DurationBetween.Check([]interface{}{time.Second, "100ms", "200ms"}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "1s is not between 100ms and 200ms")
+
+	res, msg = DurationLessThan.Check([]interface{}{time.Second, "soon"}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, `can't parse expected value: time: invalid duration "soon"`)
+
+	res, msg = DurationGreaterThan.Check([]interface{}{1.5, time.Second}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "obtained value type must be time.Duration, a duration string or integer nanoseconds, got float64")
+
+	res, msg = DurationGreaterThan.Check([]interface{}{uint64(math.MaxUint64), time.Second}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "obtained value 18446744073709551615 out of time.Duration range")
+}
+
+func (ts *Time) TestDurationCloseTo(c *C) {
+	c.Check(time.Second, DurationCloseTo, time.Second, 0)
+	c.Check(1050*time.Millisecond, DurationCloseTo, time.Second, 50*time.Millisecond)
+	c.Check(950*time.Millisecond, DurationCloseTo, "1s", "50ms")
+	c.Check(950*time.Millisecond, DurationCloseTo, "1s", "5%")
+	c.Check(940*time.Millisecond, Not(DurationCloseTo), "1s", "5%")
+	c.Check(int64(1e9), DurationCloseTo, "1s", "0.5%")
+
+	res, msg := DurationCloseTo.Check([]interface{}{1200 * time.Millisecond, time.Second, "10%"}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "1.2s is not within 100ms of 1s (difference 200ms)")
+
+	res, msg = DurationCloseTo.Check([]interface{}{time.Second, time.Second, "ten%"}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, `invalid tolerance percentage "ten%"`)
+
+	res, msg = DurationCloseTo.Check([]interface{}{time.Second, time.Second, -time.Second}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "tolerance must not be negative, got -1s")
+
+	// the difference and the percentage tolerance are out of the time.Duration range
+	maxD, minD := time.Duration(math.MaxInt64), time.Duration(math.MinInt64)
+	c.Check(maxD, Not(DurationCloseTo), minD, time.Second)
+	c.Check(minD, Not(DurationCloseTo), maxD, time.Second)
+	c.Check(maxD, DurationCloseTo, maxD-time.Second, time.Second)
+	c.Check(-maxD/2, DurationCloseTo, maxD/2, "300%")
+	c.Check(maxD, DurationCloseTo, maxD, "1e300%")
+
+	res, msg = DurationCloseTo.Check([]interface{}{maxD, minD, time.Second}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "2562047h47m16.854775807s is not within 1s of -2562047h47m16.854775808s (difference 18446744073709551615ns)")
+
+	res, msg = DurationCloseTo.Check([]interface{}{minD, maxD, "100%"}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "-2562047h47m16.854775808s is not within 2562047h47m16.854775807s of 2562047h47m16.854775807s (difference -18446744073709551615ns)")
+}
+
+func (ts *Time) TestCompletesWithin(c *C) {
+	c.Check(func() {}, CompletesWithin(time.Second))
+	c.Check(func() { time.Sleep(5 * time.Millisecond) }, Not(CompletesWithin(time.Millisecond)))
+	c.Check(CompletesWithin(time.Second).Info().Name, Equals, "CompletesWithin(1s)")
+
+	res, msg := CompletesWithin(time.Millisecond).Check([]interface{}{func() { time.Sleep(5 * time.Millisecond) }}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Matches, `took .+, more than 1ms`)
+
+	res, msg = CompletesWithin(time.Second).Check([]interface{}{func() int { return 1 }}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "obtained value must be a func(), got func() int")
+}
+
+func (ts *Time) TestWithinDurationString(c *C) {
+	t1 := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	c.Check(t1, WithinDuration, t1.Add(time.Minute), "1m")
+
+	res, msg := WithinDuration.Check([]interface{}{t1, t1, 1.5}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "max_diff value type must be time.Duration, a duration string or integer nanoseconds, got float64")
+}
@@ -0,0 +1,93 @@
+package checkers
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+
+	gc "gopkg.in/check.v1"
+)
+
+// projection checks a value derived from the obtained one.
+type projection struct {
+	name    string
+	checker gc.Checker
+	// project derives the value to check. It returns a description of the
+	// derived value, used in the failure message.
+	project func(obtained interface{}) (value interface{}, desc string, errStr string)
+}
+
+func (p *projection) Info() *gc.CheckerInfo {
+	return &gc.CheckerInfo{
+		Name:   fmt.Sprintf("%s, %s)", p.name, p.checker.Info().Name),
+		Params: []string{"obtained"},
+	}
+}
+
+func (p *projection) Check(params []interface{}, names []string) (result bool, error string) {
+	value, desc, errStr := p.project(params[0])
+	if errStr != "" {
+		return false, errStr
+	}
+	ok, explanation := runBranch(p.checker, value)
+	if ok {
+		return true, ""
+	}
+	return false, fmt.Sprintf("%s = %#v\n... %s", desc, value, explanation)
+}
+
+// Transform applies fn to the obtained value and checks the result with the
+// checker and the given arguments. The obtained value must be of type T.
+// It panics if the number of the arguments doesn't match the checker.
+// For example:
+//
+//	c.Assert(name, Transform(strings.ToLower, Equals, "bob"))
+func Transform[T, U any](fn func(T) U, checker gc.Checker, args ...interface{}) gc.Checker {
+	fname := funcName(fn)
+	return &projection{
+		name:    "Transform(" + fname,
+		checker: bindChecker(checker, args),
+		project: func(obtained interface{}) (interface{}, string, string) {
+			v, ok := obtained.(T)
+			t := reflect.TypeOf(&v).Elem()
+			if !ok && (obtained != nil || !canBeNil(t)) {
+				return nil, "", fmt.Sprintf("obtained value must be %s, got %T", t, obtained)
+			}
+			return fn(v), fname + "(obtained)", ""
+		},
+	}
+}
+
+// Field checks the value at the given path of the obtained value with the
+// checker and the given arguments. The path lists struct field names or map
+// keys separated with dots and can select slice, array or map elements, eg
+// `Items[2].Price` or `Labels[env]`. See Transform.
+// For example:
+//
+//	c.Assert(order, Field("Items[2].Price", Between, 10, 20))
+func Field(path string, checker gc.Checker, args ...interface{}) gc.Checker {
+	return &projection{
+		name:    fmt.Sprintf("Field(%q", path),
+		checker: bindChecker(checker, args),
+		project: func(obtained interface{}) (interface{}, string, string) {
+			v, errStr := lookupPath(reflect.ValueOf(obtained), path)
+			if errStr != "" {
+				return nil, "", fmt.Sprintf("can't get %s: %s", path, errStr)
+			}
+			if !v.CanInterface() {
+				return nil, "", fmt.Sprintf("can't get %s: unexported field", path)
+			}
+			return v.Interface(), path, ""
+		},
+	}
+}
+
+func funcName(fn interface{}) string {
+	name := runtime.FuncForPC(reflect.ValueOf(fn).Pointer()).Name()
+	// strip the package path
+	if i := strings.LastIndexByte(name, '/'); i >= 0 {
+		name = name[i+1:]
+	}
+	return name
+}
@@ -0,0 +1,63 @@
+package checkers
+
+import (
+	"strings"
+
+	. "gopkg.in/check.v1"
+)
+
+type TransformSuite struct{}
+
+type item struct {
+	Name  string
+	Price float64
+}
+
+type order struct {
+	Items  []item
+	Labels map[string]string
+	Buyer  *x
+}
+
+func (s *TransformSuite) TestTransform(c *C) {
+	c.Check("Bob", Transform(strings.ToLower, Equals, "bob"))
+	c.Check("a,b,c", Transform(func(s string) []string { return strings.Split(s, ",") }, SameContent, []string{"c", "b", "a"}))
+	c.Check([]int{1, 2}, Transform(func(s []int) int { return len(s) }, Between, 1, 3))
+	c.Check(nil, Transform(func(e error) bool { return e == nil }, IsTrue))
+
+	res, msg := Transform(strings.ToLower, Equals, "bob").Check([]interface{}{"Alice"}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "strings.ToLower(obtained) = \"alice\"\n... Equals(\"bob\"): failed")
+
+	res, msg = Transform(strings.ToLower, IsEmpty).Check([]interface{}{1}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "obtained value must be string, got int")
+}
+
+func (s *TransformSuite) TestField(c *C) {
+	o := &order{
+		Items:  []item{{"a", 1}, {"b", 12.5}},
+		Labels: map[string]string{"env": "prod"},
+		Buyer:  &x{"bob"},
+	}
+	c.Check(o, Field("Items[1].Price", Between, 10, 20))
+	c.Check(o, Field("Items[0].Name", Equals, "a"))
+	c.Check(o, Field("Labels[env]", Equals, "prod"))
+	c.Check(o, Field("Labels.env", HasPrefix, "pr"))
+	c.Check(o, Field("Buyer.Val", Equals, "bob"))
+	c.Check(o, Field("Items", EachElement(Field("Price", Between, 0, 20))))
+	c.Check(func() { Not(Field("Items[1].Price", Between, 4)) }, PanicMatches, "Wrong number of parameters for Between: want 2, got 1")
+	c.Check(func() { Transform(strings.ToLower, Equals) }, PanicMatches, "Wrong number of parameters for Equals: want 1, got 0")
+
+	res, msg := Field("Items[1].Price", Between, 1, 10).Check([]interface{}{o}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "Items[1].Price = 12.5\n... Between(1, 10): failed")
+
+	res, msg = Field("Items[2].Price", IsEmpty).Check([]interface{}{o}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "can't get Items[2].Price: index [2] out of range for []checkers.item of length 2")
+
+	res, msg = Field("Buyer.Val", IsEmpty).Check([]interface{}{&order{}}, nil)
+	c.Check(res, IsFalse)
+	c.Check(msg, Equals, "can't get Buyer.Val: can't select Val: nil *checkers.x")
+}
@@ -0,0 +1,169 @@
+/*
+Package typed provides a type-safe, generic layer on top of the
+github.com/robert-zaremba/checkers package.
+
+Every function returns a ready to use gc.Checker with its arguments already
+bound, so the compiler catches arguments of a wrong type:
+
+	c.Check(ids, typed.Contains(int64(42)))
+	c.Check(ratio, typed.Between(0.0, 1.0))
+	c.Check(names, typed.SameContent([]string{"a", "b"}))
+
+The obtained value is still passed as interface{}, hence its type is
+verified when the check runs and reported as a checker error.
+*/
+package typed
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/robert-zaremba/checkers"
+	gc "gopkg.in/check.v1"
+)
+
+// Integer is a constraint permitting any integer type.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Number is a constraint permitting any integer or floating point type.
+type Number interface {
+	Integer | ~float32 | ~float64
+}
+
+// -----------------------------------------------------------------------
+
+// checker is a gc.Checker which takes only the obtained value. The obtained
+// value must be of type T. The remaining arguments are bound when the checker
+// is created.
+type checker[T any] struct {
+	info  gc.CheckerInfo
+	check func(obtained T) (bool, string)
+}
+
+func newChecker[T any](name string, check func(T) (bool, string)) gc.Checker {
+	return &checker[T]{gc.CheckerInfo{Name: name, Params: []string{"obtained"}}, check}
+}
+
+func (c *checker[T]) Info() *gc.CheckerInfo {
+	info := c.info
+	return &info
+}
+
+func (c *checker[T]) Check(params []interface{}, names []string) (result bool, error string) {
+	obtained, ok := params[0].(T)
+	if !ok {
+		return false, fmt.Sprintf("obtained value must be %s, got %T", typeOf[T](), params[0])
+	}
+	return c.check(obtained)
+}
+
+func typeOf[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+// bind returns a checker which calls sub with the given arguments following
+// the obtained value.
+func bind[T any](sub gc.Checker, args ...interface{}) gc.Checker {
+	names := sub.Info().Params
+	return newChecker(sub.Info().Name, func(obtained T) (bool, string) {
+		return sub.Check(append([]interface{}{obtained}, args...), append([]string{}, names...))
+	})
+}
+
+// -----------------------------------------------------------------------
+
+// Contains checks if the obtained []T contains elem.
+func Contains[T comparable](elem T) gc.Checker {
+	return newChecker("Contains", func(obtained []T) (bool, string) {
+		for _, v := range obtained {
+			if v == elem {
+				return true, ""
+			}
+		}
+		return false, ""
+	})
+}
+
+// IsIn checks if the obtained T is an element of container.
+func IsIn[T comparable](container []T) gc.Checker {
+	return newChecker("IsIn", func(obtained T) (bool, string) {
+		for _, v := range container {
+			if v == obtained {
+				return true, ""
+			}
+		}
+		return false, ""
+	})
+}
+
+// SliceEquals checks if the obtained []T has the same values as expected.
+func SliceEquals[T any](expected []T) gc.Checker {
+	return bind[[]T](checkers.SliceEquals, expected)
+}
+
+// MapEquals checks if the obtained map[K]V has the same values as expected.
+func MapEquals[K comparable, V any](expected map[K]V) gc.Checker {
+	return bind[map[K]V](checkers.MapEquals, expected)
+}
+
+// SameContent checks if the obtained []T contains the same elements as
+// expected, ignoring the order.
+func SameContent[T any](expected []T) gc.Checker {
+	return bind[[]T](checkers.SameContent, expected)
+}
+
+// Satisfies checks if the obtained T satisfies the predicate f.
+func Satisfies[T any](f func(T) bool) gc.Checker {
+	return newChecker("Satisfies", func(obtained T) (bool, string) {
+		return f(obtained), ""
+	})
+}
+
+// HasPrefix checks if the obtained string starts with prefix.
+func HasPrefix(prefix string) gc.Checker {
+	return bind[string](checkers.HasPrefix, prefix)
+}
+
+// HasSuffix checks if the obtained string ends with suffix.
+func HasSuffix(suffix string) gc.Checker {
+	return bind[string](checkers.HasSuffix, suffix)
+}
+
+// -----------------------------------------------------------------------
+
+// Between checks if the obtained N is in the closed range [lower, upper].
+// The values are compared with the native operators of N, without any
+// conversion.
+func Between[N Number](lower, upper N) gc.Checker {
+	return newChecker("Between", func(obtained N) (bool, string) {
+		return obtained >= lower && obtained <= upper, ""
+	})
+}
+
+// EqualsWithTolerance checks if the obtained N differs from expected by at
+// most tolerance.
+func EqualsWithTolerance[N Number](expected, tolerance N) gc.Checker {
+	return newChecker("EqualsWithTolerance", func(obtained N) (bool, string) {
+		// obtained == expected handles infinities, which would otherwise
+		// produce NaN
+		if obtained == expected {
+			return true, ""
+		}
+		// subtract the smaller value to avoid unsigned underflow
+		diff := expected - obtained
+		if obtained > expected {
+			diff = obtained - expected
+		}
+		// a negative difference means that a signed subtraction overflowed,
+		// so the real difference is bigger than any tolerance of type N
+		return diff >= 0 && diff <= tolerance, ""
+	})
+}
+
+// CloseTo is an alias for EqualsWithTolerance.
+func CloseTo[N Number](expected, tolerance N) gc.Checker {
+	return EqualsWithTolerance(expected, tolerance)
+}
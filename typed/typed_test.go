@@ -0,0 +1,64 @@
+package typed
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	gc "gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) {
+	gc.Suite(&TypedSuite{})
+	gc.TestingT(t)
+}
+
+type TypedSuite struct{}
+
+func (s *TypedSuite) TestContains(c *gc.C) {
+	c.Check([]int64{1, 2, 3}, Contains(int64(2)))
+	c.Check([]int64{1, 2, 3}, gc.Not(Contains(int64(4))))
+	c.Check([]string{"a"}, Contains("a"))
+	c.Check("a", IsIn([]string{"a", "b"}))
+	c.Check("c", gc.Not(IsIn([]string{"a", "b"})))
+
+	res, msg := Contains(int64(1)).Check([]interface{}{[]int{1}}, nil)
+	c.Check(res, gc.Equals, false)
+	c.Check(msg, gc.Equals, "obtained value must be []int64, got []int")
+}
+
+func (s *TypedSuite) TestSlicesAndMaps(c *gc.C) {
+	c.Check([]int{1, 2}, SliceEquals([]int{1, 2}))
+	c.Check([]int{1, 2}, gc.Not(SliceEquals([]int{2, 1})))
+	c.Check(map[string]int{"a": 1}, MapEquals(map[string]int{"a": 1}))
+	c.Check([]int{1, 2, 2}, SameContent([]int{2, 1, 2}))
+
+	res, msg := SameContent([]int{1, 2}).Check([]interface{}{[]int{1, 3}}, nil)
+	c.Check(res, gc.Equals, false)
+	c.Check(msg, gc.Matches, "(?s)Difference:\n.*")
+}
+
+func (s *TypedSuite) TestStrings(c *gc.C) {
+	c.Check("foo bar", HasPrefix("foo"))
+	c.Check("foo bar", HasSuffix("bar"))
+	c.Check("foo bar", gc.Not(HasSuffix("foo")))
+	c.Check(42, Satisfies(func(i int) bool { return i == 42 }))
+}
+
+func (s *TypedSuite) TestNumbers(c *gc.C) {
+	c.Check(uint8(3), Between[uint8](1, 5))
+	c.Check(time.Second, Between(time.Millisecond, time.Minute))
+	c.Check(2.5, gc.Not(Between(0.0, 1.0)))
+	c.Check(int64(math.MaxInt64), gc.Not(Between[int64](0, math.MaxInt64-1)))
+
+	c.Check(uint(3), EqualsWithTolerance[uint](5, 2))
+	c.Check(uint(5), CloseTo[uint](3, 2))
+	c.Check(uint(6), gc.Not(CloseTo[uint](3, 2)))
+	c.Check(1.0, CloseTo(1.25, 0.5))
+	c.Check(int8(127), gc.Not(CloseTo[int8](-128, 100)))
+	c.Check(int64(math.MaxInt64), gc.Not(CloseTo[int64](math.MaxInt64-1, 0)))
+	c.Check(math.Inf(1), CloseTo(math.Inf(1), 0.0))
+	c.Check(math.Inf(-1), EqualsWithTolerance(math.Inf(-1), 1.0))
+	c.Check(math.Inf(1), gc.Not(CloseTo(1.0, 1e300)))
+	c.Check(math.NaN(), gc.Not(CloseTo(math.NaN(), 1.0)))
+}
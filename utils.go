@@ -2,6 +2,8 @@ package checkers
 
 import (
 	"fmt"
+
+	gc "gopkg.in/check.v1"
 )
 
 func stringOrStringer(value interface{}) (string, bool) {
@@ -13,3 +15,13 @@ func stringOrStringer(value interface{}) (string, bool) {
 	}
 	return result, isString
 }
+
+// paramName returns the name of the i-th parameter of a checker. The names
+// passed to Check are used if there are enough of them, as they may be nil
+// when a checker is called directly or through Bind.
+func paramName(info *gc.CheckerInfo, names []string, i int) string {
+	if i < len(names) {
+		return names[i]
+	}
+	return info.Params[i]
+}